@@ -1,4 +0,0 @@
-# Get a description by object name.
-data "salesforce_description" "test" {
-  name = "test"
-}
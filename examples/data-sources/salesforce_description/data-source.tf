@@ -0,0 +1,33 @@
+# Get a description by object name.
+data "salesforce_description" "test" {
+  name = "test"
+}
+
+# Values of a picklist field, and for a dependent picklist the values valid
+# for each value of its controlling field.
+locals {
+  collections = data.salesforce_description.test.fields_by_name["vub_Trained_Collections__c"]
+}
+
+output "collections" {
+  value = [for v in local.collections.picklist_values : v.value if v.active]
+}
+
+output "collections_by_controlling_value" {
+  value = local.collections.dependent_values
+}
+
+# Limit large objects to the fields of interest.
+data "salesforce_description" "account" {
+  name        = "Account"
+  field_names = ["Name", "Industry"]
+}
+
+output "industry_label" {
+  value = data.salesforce_description.account.fields_by_name["Industry"].label
+}
+
+# Record type IDs differ between sandboxes, look them up by API name.
+output "product_training_record_type_id" {
+  value = data.salesforce_description.test.record_type_ids["VuB_Product_Training"]
+}
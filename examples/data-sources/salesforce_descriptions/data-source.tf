@@ -0,0 +1,13 @@
+# Describe several objects in one round trip.
+data "salesforce_descriptions" "reference_data" {
+  names = ["Account", "Contact", "vub_Training__c"]
+
+  # Limit large objects to the fields of interest.
+  field_names = {
+    Account = ["Name", "Industry"]
+  }
+}
+
+output "key_prefixes" {
+  value = { for d in data.salesforce_descriptions.reference_data.descriptions : d.name => d.key_prefix }
+}
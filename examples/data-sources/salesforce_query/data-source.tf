@@ -0,0 +1,18 @@
+# Look up queue IDs by developer name.
+data "salesforce_query" "queues" {
+  query = "SELECT Id, DeveloperName FROM Group WHERE Type = 'Queue'"
+}
+
+locals {
+  queue_ids = { for r in data.salesforce_query.queues.records : jsondecode(r).DeveloperName => jsondecode(r).Id }
+}
+
+# A single user by email.
+data "salesforce_query" "integration_user" {
+  query       = "SELECT Id FROM User WHERE Email = 'integration@example.com'"
+  max_records = 1
+}
+
+output "integration_user_id" {
+  value = jsondecode(data.salesforce_query.integration_user.records[0]).Id
+}
@@ -0,0 +1,17 @@
+# Fetch a record by ID.
+data "salesforce_record" "standard_pricebook" {
+  sobject_type = "Pricebook2"
+  id           = "01s69000000KXyzAAG"
+}
+
+# Fetch a record by a stable external ID, limited to some fields.
+data "salesforce_record" "default_business_hours" {
+  sobject_type      = "BusinessHours"
+  external_id_field = "vub_External_Id__c"
+  external_id_value = "DEFAULT"
+  fields            = ["Name", "TimeZoneSidKey"]
+}
+
+output "business_hours_time_zone" {
+  value = data.salesforce_record.default_business_hours.values["TimeZoneSidKey"]
+}
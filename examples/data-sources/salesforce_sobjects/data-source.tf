@@ -0,0 +1,12 @@
+# List the custom objects of a managed package.
+data "salesforce_sobjects" "vub" {
+  custom_only  = true
+  name_pattern = "^vub_.*__c$"
+}
+
+# Describe every listed object.
+data "salesforce_description" "vub" {
+  for_each = toset([for o in data.salesforce_sobjects.vub.sobjects : o.name])
+
+  name = each.value
+}
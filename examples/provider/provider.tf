@@ -8,4 +8,40 @@ provider "salesforce" {
   grant_type    = "password"
   username      = "admin"
   password      = "password123"
+
+  retry {
+    max_retries = 5
+    max_backoff = "1m"
+  }
+
+  api_usage {
+    threshold = 80
+    action    = "stop"
+  }
+
+  # keep describe results between runs, revalidated at most once an hour
+  describe_cache {
+    directory = ".terraform/salesforce-describe"
+    max_age   = "1h"
+  }
+}
+
+# authenticate a connected app with a signed JWT instead of a password
+provider "salesforce" {
+  alias       = "jwt"
+  api_version = "v59.0"
+  auth_host   = "https://login.salesforce.com/services/oauth2/token"
+  client_id   = "idisjisjisjsfjs"
+  grant_type  = "urn:ietf:params:oauth:grant-type:jwt-bearer"
+  username    = "ci@example.com"
+  private_key = "/path/to/server.key"
+  audience    = "https://login.salesforce.com"
+}
+
+# reuse an access token issued by the Salesforce CLI (sf org display)
+provider "salesforce" {
+  alias        = "cli"
+  api_version  = "v59.0"
+  access_token = "00D...!AQ..."
+  instance_url = "https://xyz.my.salesforce.com"
 }
@@ -0,0 +1,2 @@
+# Custom fields can be imported by the API names of the object and the field.
+terraform import salesforce_custom_field.duration vub_Training__c.vub_Duration__c
@@ -0,0 +1,82 @@
+# A number field.
+resource "salesforce_custom_field" "duration" {
+  object    = salesforce_custom_object.training.api_name
+  api_name  = "vub_Duration__c"
+  label     = "Duration (Hours)"
+  type      = "Number"
+  precision = 4
+  scale     = 1
+  required  = true
+  help_text = "Length of the training in hours."
+}
+
+# A restricted picklist.
+resource "salesforce_custom_field" "level" {
+  object   = salesforce_custom_object.training.api_name
+  api_name = "vub_Level__c"
+  label    = "Level"
+  type     = "Picklist"
+
+  picklist = {
+    restricted = true
+    values = [
+      { api_name = "Beginner", default = true },
+      { api_name = "Advanced" },
+      { api_name = "Expert", label = "Expert (Certified)" },
+    ]
+  }
+
+  track_history = true
+}
+
+# A text field for upserts by an external key.
+resource "salesforce_custom_field" "external_key" {
+  object      = salesforce_custom_object.training.api_name
+  api_name    = "vub_External_Key__c"
+  label       = "External Key"
+  type        = "Text"
+  length      = 40
+  unique      = true
+  external_id = true
+}
+
+# A checkbox needs a default value.
+resource "salesforce_custom_field" "mandatory" {
+  object        = salesforce_custom_object.training.api_name
+  api_name      = "vub_Mandatory__c"
+  label         = "Mandatory"
+  type          = "Checkbox"
+  default_value = "false"
+}
+
+# A master-detail relationship of bookings to their training.
+resource "salesforce_custom_field" "booking_training" {
+  object            = "vub_Booking__c"
+  api_name          = "vub_Training__c"
+  label             = "Training"
+  type              = "MasterDetail"
+  reference_to      = salesforce_custom_object.training.api_name
+  relationship_name = "Bookings"
+}
+
+# A roll-up summary counting the confirmed bookings of a training.
+resource "salesforce_custom_field" "confirmed_bookings" {
+  object              = salesforce_custom_object.training.api_name
+  api_name            = "vub_Confirmed_Bookings__c"
+  label               = "Confirmed Bookings"
+  type                = "Summary"
+  summary_operation   = "count"
+  summary_foreign_key = "vub_Booking__c.${salesforce_custom_field.booking_training.api_name}"
+  summary_filters = [
+    { field = "vub_Booking__c.vub_Status__c", operation = "equals", value = "Confirmed" },
+  ]
+}
+
+# A formula field has the type of its result.
+resource "salesforce_custom_field" "long_training" {
+  object   = salesforce_custom_object.training.api_name
+  api_name = "vub_Long_Training__c"
+  label    = "Long Training"
+  type     = "Checkbox"
+  formula  = "${salesforce_custom_field.duration.api_name} > 8"
+}
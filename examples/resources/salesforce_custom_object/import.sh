@@ -0,0 +1,2 @@
+# Custom objects can be imported by API name.
+terraform import salesforce_custom_object.training vub_Training__c
@@ -0,0 +1,20 @@
+# A custom object with a numbered name field.
+resource "salesforce_custom_object" "training" {
+  api_name          = "vub_Training__c"
+  label             = "Training"
+  plural_label      = "Trainings"
+  description       = "Trainings booked by employees."
+  name_field_label  = "Training Number"
+  name_field_type   = "AutoNumber"
+  name_field_format = "T-{0000}"
+  sharing_model     = "Private"
+
+  enable_history = true
+  enable_reports = true
+  enable_search  = true
+
+  # Enabled together as the Enterprise Application settings.
+  enable_bulk_api      = true
+  enable_sharing       = true
+  enable_streaming_api = true
+}
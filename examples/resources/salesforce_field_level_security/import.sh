@@ -0,0 +1,3 @@
+# Field-level security can be imported by the API names of the object and the
+# field. The import adopts the access of all permission sets and profiles.
+terraform import salesforce_field_level_security.duration vub_Training__c.vub_Duration__c
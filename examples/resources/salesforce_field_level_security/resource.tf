@@ -0,0 +1,13 @@
+# Grant access to a new field together with creating it.
+resource "salesforce_field_level_security" "duration" {
+  field = salesforce_custom_field.duration.id
+
+  permission_sets = {
+    vub_Integration = { read = true, edit = true }
+    vub_Reporting   = { read = true }
+  }
+
+  profiles = {
+    "Standard User" = { read = true }
+  }
+}
@@ -0,0 +1,2 @@
+# Permission sets can be imported by API name.
+terraform import salesforce_permission_set.integration vub_Integration
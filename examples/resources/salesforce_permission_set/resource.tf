@@ -0,0 +1,28 @@
+# Access of the integration user to trainings.
+resource "salesforce_permission_set" "integration" {
+  api_name    = "vub_Integration"
+  label       = "Integration"
+  description = "Access of the ERP integration."
+  license     = "Salesforce Integration"
+
+  user_permissions = ["ApiEnabled", "ApiUserOnly"]
+  class_accesses   = ["vub_TrainingService"]
+
+  object_permissions {
+    object       = salesforce_custom_object.training.api_name
+    allow_create = true
+    allow_read   = true
+    allow_edit   = true
+  }
+
+  field_permissions {
+    field    = salesforce_custom_field.duration.id
+    readable = true
+    editable = true
+  }
+
+  tab_settings {
+    tab        = salesforce_custom_object.training.api_name
+    visibility = "Available"
+  }
+}
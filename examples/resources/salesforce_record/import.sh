@@ -0,0 +1,2 @@
+# Records can be imported by sObject type and record ID.
+terraform import salesforce_record.default_setting vub_Setting__c/a0B69000001XyZ1EAK
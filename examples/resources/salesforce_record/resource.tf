@@ -0,0 +1,31 @@
+# A row of a lookup table, field values are converted to the field types.
+resource "salesforce_record" "default_setting" {
+  sobject_type = "vub_Setting__c"
+  fields = {
+    Name          = "Default"
+    vub_Active__c = "true"
+    vub_Limit__c  = "10.5"
+  }
+}
+
+# Reference the ID of another record.
+resource "salesforce_record" "child_setting" {
+  sobject_type = "vub_Setting__c"
+  fields = {
+    Name            = "Child"
+    vub_Parent__c   = salesforce_record.default_setting.id
+    vub_Comments__c = ""
+  }
+}
+
+# Adopt the record with the same external ID if it exists, e.g. in a
+# refreshed sandbox, instead of creating a duplicate.
+resource "salesforce_record" "region_setting" {
+  sobject_type      = "vub_Setting__c"
+  external_id_field = "vub_Key__c"
+  fields = {
+    vub_Key__c    = "EMEA"
+    Name          = "EMEA"
+    vub_Active__c = "true"
+  }
+}
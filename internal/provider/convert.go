@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// stringValues converts a list of strings of the Salesforce API. A nil list
+// becomes an empty one, so lists in state are never null.
+func stringValues(values []string) []types.String {
+	result := make([]types.String, 0, len(values))
+	for _, value := range values {
+		result = append(result, types.StringValue(value))
+	}
+
+	return result
+}
+
+// int64PointerValue converts an optional integer of the Salesforce API.
+func int64PointerValue(value *int) types.Int64 {
+	if value == nil {
+		return types.Int64Null()
+	}
+
+	return types.Int64Value(int64(*value))
+}
+
+// jsonValueString converts a JSON value of any type to a string. Strings are
+// unquoted, other values keep their JSON representation and null becomes a
+// null string.
+func jsonValueString(raw json.RawMessage) types.String {
+	if len(raw) == 0 || string(raw) == "null" {
+		return types.StringNull()
+	}
+
+	var value string
+	if err := json.Unmarshal(raw, &value); err == nil {
+		return types.StringValue(value)
+	}
+
+	return types.StringValue(string(raw))
+}
+
+// optionalStringValue converts a string the Metadata API omits when it is
+// empty, so an unset optional attribute stays null.
+func optionalStringValue(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(value)
+}
+
+// intPointer converts an optional integer for the Salesforce API.
+func intPointer(value types.Int64) *int {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	result := int(value.ValueInt64())
+	return &result
+}
+
+// optionalBoolValue converts a flag the Metadata API reports as false when
+// it is not set, so a flag that was null stays null.
+func optionalBoolValue(value bool, prior types.Bool) types.Bool {
+	if !value && prior.IsNull() {
+		return types.BoolNull()
+	}
+
+	return types.BoolValue(value)
+}
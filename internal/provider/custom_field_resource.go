@@ -0,0 +1,1109 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/villeroy-boch/terraform-provider-salesforce/internal/salesforce"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &customFieldResource{}
+	_ resource.ResourceWithConfigure      = &customFieldResource{}
+	_ resource.ResourceWithImportState    = &customFieldResource{}
+	_ resource.ResourceWithModifyPlan     = &customFieldResource{}
+	_ resource.ResourceWithValidateConfig = &customFieldResource{}
+)
+
+// customFieldTypes are the types of custom fields the resource manages.
+var customFieldTypes = []string{
+	salesforce.CustomFieldTypeCheckbox,
+	salesforce.CustomFieldTypeCurrency,
+	salesforce.CustomFieldTypeDate,
+	salesforce.CustomFieldTypeDateTime,
+	salesforce.CustomFieldTypeEmail,
+	salesforce.CustomFieldTypeLongTextArea,
+	salesforce.CustomFieldTypeLookup,
+	salesforce.CustomFieldTypeMasterDetail,
+	salesforce.CustomFieldTypeMultiselectPicklist,
+	salesforce.CustomFieldTypeNumber,
+	salesforce.CustomFieldTypePercent,
+	salesforce.CustomFieldTypePhone,
+	salesforce.CustomFieldTypePicklist,
+	salesforce.CustomFieldTypeSummary,
+	salesforce.CustomFieldTypeText,
+	salesforce.CustomFieldTypeTextArea,
+	salesforce.CustomFieldTypeURL,
+}
+
+// customFieldFormulaTypes are the result types of formula fields.
+var customFieldFormulaTypes = []string{
+	salesforce.CustomFieldTypeCheckbox,
+	salesforce.CustomFieldTypeCurrency,
+	salesforce.CustomFieldTypeDate,
+	salesforce.CustomFieldTypeDateTime,
+	salesforce.CustomFieldTypeNumber,
+	salesforce.CustomFieldTypePercent,
+	salesforce.CustomFieldTypeText,
+}
+
+// customFieldAttributeTypes lists the only field types supporting an
+// attribute. Attributes not listed apply to all types.
+var customFieldAttributeTypes = map[string][]string{
+	"length":                  {salesforce.CustomFieldTypeText, salesforce.CustomFieldTypeLongTextArea},
+	"precision":               {salesforce.CustomFieldTypeNumber, salesforce.CustomFieldTypeCurrency, salesforce.CustomFieldTypePercent},
+	"scale":                   {salesforce.CustomFieldTypeNumber, salesforce.CustomFieldTypeCurrency, salesforce.CustomFieldTypePercent},
+	"visible_lines":           {salesforce.CustomFieldTypeLongTextArea, salesforce.CustomFieldTypeMultiselectPicklist},
+	"picklist":                {salesforce.CustomFieldTypePicklist, salesforce.CustomFieldTypeMultiselectPicklist},
+	"reference_to":            {salesforce.CustomFieldTypeLookup, salesforce.CustomFieldTypeMasterDetail},
+	"relationship_name":       {salesforce.CustomFieldTypeLookup, salesforce.CustomFieldTypeMasterDetail},
+	"relationship_label":      {salesforce.CustomFieldTypeLookup, salesforce.CustomFieldTypeMasterDetail},
+	"delete_constraint":       {salesforce.CustomFieldTypeLookup},
+	"summary_operation":       {salesforce.CustomFieldTypeSummary},
+	"summarized_field":        {salesforce.CustomFieldTypeSummary},
+	"summary_foreign_key":     {salesforce.CustomFieldTypeSummary},
+	"summary_filters":         {salesforce.CustomFieldTypeSummary},
+	"formula":                 customFieldFormulaTypes,
+	"formula_treat_blanks_as": customFieldFormulaTypes,
+	"unique":                  {salesforce.CustomFieldTypeText, salesforce.CustomFieldTypeNumber, salesforce.CustomFieldTypeEmail},
+	"external_id":             {salesforce.CustomFieldTypeText, salesforce.CustomFieldTypeNumber, salesforce.CustomFieldTypeEmail},
+	"required": {
+		salesforce.CustomFieldTypeCurrency, salesforce.CustomFieldTypeDate, salesforce.CustomFieldTypeDateTime,
+		salesforce.CustomFieldTypeEmail, salesforce.CustomFieldTypeLongTextArea, salesforce.CustomFieldTypeLookup,
+		salesforce.CustomFieldTypeMultiselectPicklist, salesforce.CustomFieldTypeNumber, salesforce.CustomFieldTypePercent,
+		salesforce.CustomFieldTypePhone, salesforce.CustomFieldTypePicklist, salesforce.CustomFieldTypeText,
+		salesforce.CustomFieldTypeTextArea, salesforce.CustomFieldTypeURL,
+	},
+	"default_value": {
+		salesforce.CustomFieldTypeCheckbox, salesforce.CustomFieldTypeCurrency, salesforce.CustomFieldTypeDate,
+		salesforce.CustomFieldTypeDateTime, salesforce.CustomFieldTypeEmail, salesforce.CustomFieldTypeLongTextArea,
+		salesforce.CustomFieldTypeNumber, salesforce.CustomFieldTypePercent, salesforce.CustomFieldTypePhone,
+		salesforce.CustomFieldTypeText, salesforce.CustomFieldTypeTextArea, salesforce.CustomFieldTypeURL,
+	},
+}
+
+// customFieldNonFormulaAttributes are the attributes formula fields do not
+// support, as their values are calculated.
+var customFieldNonFormulaAttributes = []string{
+	"length", "precision", "default_value", "required", "unique", "external_id", "track_history", "track_feed_history",
+}
+
+// customFieldConversions lists the types Salesforce can change a field to in
+// place. Any other change of the type replaces the field.
+var customFieldConversions = map[string][]string{
+	salesforce.CustomFieldTypeText: {
+		salesforce.CustomFieldTypeTextArea, salesforce.CustomFieldTypeLongTextArea, salesforce.CustomFieldTypeEmail,
+		salesforce.CustomFieldTypePhone, salesforce.CustomFieldTypeURL, salesforce.CustomFieldTypePicklist,
+		salesforce.CustomFieldTypeMultiselectPicklist, salesforce.CustomFieldTypeNumber, salesforce.CustomFieldTypeCurrency,
+		salesforce.CustomFieldTypePercent, salesforce.CustomFieldTypeDate, salesforce.CustomFieldTypeDateTime,
+	},
+	salesforce.CustomFieldTypeTextArea: {
+		salesforce.CustomFieldTypeText, salesforce.CustomFieldTypeLongTextArea, salesforce.CustomFieldTypeEmail,
+		salesforce.CustomFieldTypePhone, salesforce.CustomFieldTypeURL, salesforce.CustomFieldTypePicklist,
+		salesforce.CustomFieldTypeMultiselectPicklist,
+	},
+	salesforce.CustomFieldTypeLongTextArea: {salesforce.CustomFieldTypeText, salesforce.CustomFieldTypeTextArea},
+	salesforce.CustomFieldTypeEmail: {
+		salesforce.CustomFieldTypeText, salesforce.CustomFieldTypeTextArea, salesforce.CustomFieldTypeLongTextArea,
+		salesforce.CustomFieldTypePhone, salesforce.CustomFieldTypeURL, salesforce.CustomFieldTypePicklist,
+	},
+	salesforce.CustomFieldTypePhone: {
+		salesforce.CustomFieldTypeText, salesforce.CustomFieldTypeTextArea, salesforce.CustomFieldTypeLongTextArea,
+		salesforce.CustomFieldTypeEmail, salesforce.CustomFieldTypeURL, salesforce.CustomFieldTypePicklist,
+	},
+	salesforce.CustomFieldTypeURL: {
+		salesforce.CustomFieldTypeText, salesforce.CustomFieldTypeTextArea, salesforce.CustomFieldTypeLongTextArea,
+		salesforce.CustomFieldTypeEmail, salesforce.CustomFieldTypePhone, salesforce.CustomFieldTypePicklist,
+	},
+	salesforce.CustomFieldTypeNumber:   {salesforce.CustomFieldTypeCurrency, salesforce.CustomFieldTypePercent, salesforce.CustomFieldTypeText},
+	salesforce.CustomFieldTypeCurrency: {salesforce.CustomFieldTypeNumber, salesforce.CustomFieldTypePercent, salesforce.CustomFieldTypeText},
+	salesforce.CustomFieldTypePercent:  {salesforce.CustomFieldTypeNumber, salesforce.CustomFieldTypeCurrency, salesforce.CustomFieldTypeText},
+	salesforce.CustomFieldTypeDate:     {salesforce.CustomFieldTypeDateTime, salesforce.CustomFieldTypeText},
+	salesforce.CustomFieldTypeDateTime: {salesforce.CustomFieldTypeDate, salesforce.CustomFieldTypeText},
+	salesforce.CustomFieldTypePicklist: {
+		salesforce.CustomFieldTypeMultiselectPicklist, salesforce.CustomFieldTypeText, salesforce.CustomFieldTypeTextArea,
+	},
+	salesforce.CustomFieldTypeMultiselectPicklist: {
+		salesforce.CustomFieldTypePicklist, salesforce.CustomFieldTypeText, salesforce.CustomFieldTypeTextArea,
+	},
+	salesforce.CustomFieldTypeLookup:       {salesforce.CustomFieldTypeMasterDetail},
+	salesforce.CustomFieldTypeMasterDetail: {salesforce.CustomFieldTypeLookup},
+}
+
+// NewCustomFieldResource is a helper function to simplify the provider implementation.
+func NewCustomFieldResource() resource.Resource {
+	return &customFieldResource{}
+}
+
+// customFieldResource is the resource implementation.
+type customFieldResource struct {
+	client *salesforce.Client
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *customFieldResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring Salesforce Custom Field resource")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*salesforce.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *salesforce.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+
+	tflog.Info(ctx, "Configured Salesforce Custom Field resource", map[string]any{"success": true})
+}
+
+// Metadata returns the resource type name.
+func (r *customFieldResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_field"
+}
+
+// Schema defines the schema for the resource.
+func (r *customFieldResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a custom field of an object through the Metadata API. " +
+			"Changes of the type that Salesforce cannot make in place replace the field, which deletes its values in all records.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Full name of the field, the API names of the object and the field joined by a dot, e.g. `vub_Training__c.vub_Duration__c`.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"object": schema.StringAttribute{
+				Description: "API name of the object of the field. Changing it replaces the field.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"api_name": schema.StringAttribute{
+				Description: "API name of the field including the `__c` suffix. Changing it replaces the field.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"label": schema.StringAttribute{
+				Description: "Label of the field.",
+				Required:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Type of the field, one of " + markdownList(customFieldTypes) + ". " +
+					"`Summary` is a roll-up summary field, formula fields have the type of their result. " +
+					"Changes Salesforce cannot make in place, e.g. from or to `Checkbox` or `Summary`, replace the field.",
+				Required: true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the field.",
+				Optional:    true,
+			},
+			"help_text": schema.StringAttribute{
+				Description: "Help text shown next to the field.",
+				Optional:    true,
+			},
+			"default_value": schema.StringAttribute{
+				Description: "Default value of new records as formula expression, e.g. `\"'EMEA'\"` for text or `\"TODAY()\"` for dates. " +
+					"Required for `Checkbox` fields, which default to `\"true\"` or `\"false\"`.",
+				Optional: true,
+			},
+			"required":           customFieldFlagAttribute("Whether the field must have a value."),
+			"unique":             customFieldFlagAttribute("Whether values must be unique. Only for `Text`, `Number` and `Email` fields."),
+			"external_id":        customFieldFlagAttribute("Whether the field is an external ID, which records can be upserted by. Only for `Text`, `Number` and `Email` fields."),
+			"track_history":      customFieldFlagAttribute("Whether changes are tracked in the field history of the object."),
+			"track_feed_history": customFieldFlagAttribute("Whether changes are tracked in the Chatter feed."),
+			"track_trending":     customFieldFlagAttribute("Whether the field is tracked for historical trend reporting."),
+			"length": schema.Int64Attribute{
+				Description: "Maximum number of characters. Required for `Text` fields (1 to 255) and `LongTextArea` fields (256 to 131072).",
+				Optional:    true,
+			},
+			"precision": schema.Int64Attribute{
+				Description: "Total number of digits, at most 18. Required for `Number`, `Currency` and `Percent` fields that are no formulas.",
+				Optional:    true,
+			},
+			"scale": schema.Int64Attribute{
+				Description: "Number of digits after the decimal point. Required for `Number`, `Currency` and `Percent` fields.",
+				Optional:    true,
+			},
+			"visible_lines": schema.Int64Attribute{
+				Description: "Number of lines shown in forms. Required for `LongTextArea` and `MultiselectPicklist` fields.",
+				Optional:    true,
+			},
+			"picklist": schema.SingleNestedAttribute{
+				Description: "Values of a `Picklist` or `MultiselectPicklist` field, either listed in `values` or referencing a global value set.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"restricted": schema.BoolAttribute{
+						Description: "Whether only the listed values can be saved.",
+						Optional:    true,
+					},
+					"sorted": schema.BoolAttribute{
+						Description: "Whether values are sorted alphabetically instead of in the listed order.",
+						Optional:    true,
+					},
+					"value_set_name": schema.StringAttribute{
+						Description: "API name of the global value set providing the values.",
+						Optional:    true,
+					},
+					"controlling_field": schema.StringAttribute{
+						Description: "API name of the field controlling which values are available.",
+						Optional:    true,
+					},
+					"values": schema.ListNestedAttribute{
+						Description: "Values of the picklist in the order they are shown.",
+						Optional:    true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"api_name": schema.StringAttribute{
+									Description: "API name of the value, which is saved in records.",
+									Required:    true,
+								},
+								"label": schema.StringAttribute{
+									Description: "Label of the value. Defaults to the API name.",
+									Optional:    true,
+								},
+								"default": schema.BoolAttribute{
+									Description: "Whether the value is selected in new records.",
+									Optional:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+			"reference_to": schema.StringAttribute{
+				Description: "API name of the object a `Lookup` or `MasterDetail` field references. Changing it replaces the field.",
+				Optional:    true,
+			},
+			"relationship_name": schema.StringAttribute{
+				Description: "API name of the relationship of a `Lookup` or `MasterDetail` field, without the `__r` suffix.",
+				Optional:    true,
+			},
+			"relationship_label": schema.StringAttribute{
+				Description: "Label of the related list on the referenced object.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"delete_constraint": schema.StringAttribute{
+				Description: "What happens to records of a `Lookup` field when the referenced record is deleted, " +
+					"one of `SetNull`, `Restrict` or `Cascade`. Defaults to `SetNull`, or `Restrict` for required fields.",
+				Optional: true,
+				Computed: true,
+			},
+			"formula": schema.StringAttribute{
+				Description: "Formula calculating the value, which makes the field a formula field. Adding or removing the formula replaces the field.",
+				Optional:    true,
+			},
+			"formula_treat_blanks_as": schema.StringAttribute{
+				Description: "Either `BlankAsZero` or `BlankAsBlank`, how the formula treats blank fields.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"summary_operation": schema.StringAttribute{
+				Description: "Aggregation of a `Summary` field, one of `count`, `sum`, `min` or `max`.",
+				Optional:    true,
+			},
+			"summary_foreign_key": schema.StringAttribute{
+				Description: "Master-detail field of the summarized object, e.g. `vub_Booking__c.vub_Training__c`.",
+				Optional:    true,
+			},
+			"summarized_field": schema.StringAttribute{
+				Description: "Field of the summarized object to aggregate, e.g. `vub_Booking__c.vub_Price__c`. Required unless `summary_operation` is `count`.",
+				Optional:    true,
+			},
+			"summary_filters": schema.ListNestedAttribute{
+				Description: "Criteria of the records a `Summary` field aggregates.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"field": schema.StringAttribute{
+							Description: "Field of the summarized object, e.g. `vub_Booking__c.vub_Status__c`.",
+							Required:    true,
+						},
+						"operation": schema.StringAttribute{
+							Description: "Comparison, e.g. `equals`, `notEqual`, `lessThan` or `greaterThan`.",
+							Required:    true,
+						},
+						"value": schema.StringAttribute{
+							Description: "Value to compare with.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// customFieldFlagAttribute returns the schema of a setting of a custom field
+// that is off by default.
+func customFieldFlagAttribute(description string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Description: description + " Defaults to `false`.",
+		Optional:    true,
+		Computed:    true,
+		Default:     booldefault.StaticBool(false),
+	}
+}
+
+// customFieldResourceModel maps the resource schema data.
+type customFieldResourceModel struct {
+	ID                   types.String              `tfsdk:"id"`
+	Object               types.String              `tfsdk:"object"`
+	APIName              types.String              `tfsdk:"api_name"`
+	Label                types.String              `tfsdk:"label"`
+	Type                 types.String              `tfsdk:"type"`
+	Description          types.String              `tfsdk:"description"`
+	HelpText             types.String              `tfsdk:"help_text"`
+	DefaultValue         types.String              `tfsdk:"default_value"`
+	Required             types.Bool                `tfsdk:"required"`
+	Unique               types.Bool                `tfsdk:"unique"`
+	ExternalID           types.Bool                `tfsdk:"external_id"`
+	TrackHistory         types.Bool                `tfsdk:"track_history"`
+	TrackFeedHistory     types.Bool                `tfsdk:"track_feed_history"`
+	TrackTrending        types.Bool                `tfsdk:"track_trending"`
+	Length               types.Int64               `tfsdk:"length"`
+	Precision            types.Int64               `tfsdk:"precision"`
+	Scale                types.Int64               `tfsdk:"scale"`
+	VisibleLines         types.Int64               `tfsdk:"visible_lines"`
+	Picklist             *customFieldPicklistModel `tfsdk:"picklist"`
+	ReferenceTo          types.String              `tfsdk:"reference_to"`
+	RelationshipName     types.String              `tfsdk:"relationship_name"`
+	RelationshipLabel    types.String              `tfsdk:"relationship_label"`
+	DeleteConstraint     types.String              `tfsdk:"delete_constraint"`
+	Formula              types.String              `tfsdk:"formula"`
+	FormulaTreatBlanksAs types.String              `tfsdk:"formula_treat_blanks_as"`
+	SummaryOperation     types.String              `tfsdk:"summary_operation"`
+	SummaryForeignKey    types.String              `tfsdk:"summary_foreign_key"`
+	SummarizedField      types.String              `tfsdk:"summarized_field"`
+	SummaryFilters       []customFieldFilterModel  `tfsdk:"summary_filters"`
+}
+
+type customFieldPicklistModel struct {
+	Restricted       types.Bool                      `tfsdk:"restricted"`
+	Sorted           types.Bool                      `tfsdk:"sorted"`
+	ValueSetName     types.String                    `tfsdk:"value_set_name"`
+	ControllingField types.String                    `tfsdk:"controlling_field"`
+	Values           []customFieldPicklistValueModel `tfsdk:"values"`
+}
+
+type customFieldPicklistValueModel struct {
+	APIName types.String `tfsdk:"api_name"`
+	Label   types.String `tfsdk:"label"`
+	Default types.Bool   `tfsdk:"default"`
+}
+
+type customFieldFilterModel struct {
+	Field     types.String `tfsdk:"field"`
+	Operation types.String `tfsdk:"operation"`
+	Value     types.String `tfsdk:"value"`
+}
+
+// customFieldConfigModel maps the configuration ValidateConfig checks. The
+// nested attributes are kept as framework values, as they may be unknown
+// while validating, e.g. when they are built with for expressions.
+type customFieldConfigModel struct {
+	ID                   types.String `tfsdk:"id"`
+	Object               types.String `tfsdk:"object"`
+	APIName              types.String `tfsdk:"api_name"`
+	Label                types.String `tfsdk:"label"`
+	Type                 types.String `tfsdk:"type"`
+	Description          types.String `tfsdk:"description"`
+	HelpText             types.String `tfsdk:"help_text"`
+	DefaultValue         types.String `tfsdk:"default_value"`
+	Required             types.Bool   `tfsdk:"required"`
+	Unique               types.Bool   `tfsdk:"unique"`
+	ExternalID           types.Bool   `tfsdk:"external_id"`
+	TrackHistory         types.Bool   `tfsdk:"track_history"`
+	TrackFeedHistory     types.Bool   `tfsdk:"track_feed_history"`
+	TrackTrending        types.Bool   `tfsdk:"track_trending"`
+	Length               types.Int64  `tfsdk:"length"`
+	Precision            types.Int64  `tfsdk:"precision"`
+	Scale                types.Int64  `tfsdk:"scale"`
+	VisibleLines         types.Int64  `tfsdk:"visible_lines"`
+	Picklist             types.Object `tfsdk:"picklist"`
+	ReferenceTo          types.String `tfsdk:"reference_to"`
+	RelationshipName     types.String `tfsdk:"relationship_name"`
+	RelationshipLabel    types.String `tfsdk:"relationship_label"`
+	DeleteConstraint     types.String `tfsdk:"delete_constraint"`
+	Formula              types.String `tfsdk:"formula"`
+	FormulaTreatBlanksAs types.String `tfsdk:"formula_treat_blanks_as"`
+	SummaryOperation     types.String `tfsdk:"summary_operation"`
+	SummaryForeignKey    types.String `tfsdk:"summary_foreign_key"`
+	SummarizedField      types.String `tfsdk:"summarized_field"`
+	SummaryFilters       types.List   `tfsdk:"summary_filters"`
+}
+
+type customFieldPicklistConfigModel struct {
+	Restricted       types.Bool   `tfsdk:"restricted"`
+	Sorted           types.Bool   `tfsdk:"sorted"`
+	ValueSetName     types.String `tfsdk:"value_set_name"`
+	ControllingField types.String `tfsdk:"controlling_field"`
+	Values           types.List   `tfsdk:"values"`
+}
+
+// ValidateConfig checks the attributes against the type of the field, so
+// fields Salesforce would reject fail the plan instead of the apply.
+func (r *customFieldResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config customFieldConfigModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.APIName.IsUnknown() && !strings.HasSuffix(config.APIName.ValueString(), "__c") {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_name"),
+			"Invalid Custom Field API Name",
+			fmt.Sprintf("The API name of a custom field must end with __c, got: %q", config.APIName.ValueString()),
+		)
+	}
+
+	validateOneOf(&resp.Diagnostics, path.Root("type"), config.Type, customFieldTypes...)
+	validateOneOf(&resp.Diagnostics, path.Root("delete_constraint"), config.DeleteConstraint, "SetNull", "Restrict", "Cascade")
+	validateOneOf(&resp.Diagnostics, path.Root("formula_treat_blanks_as"), config.FormulaTreatBlanksAs, "BlankAsZero", "BlankAsBlank")
+	validateOneOf(&resp.Diagnostics, path.Root("summary_operation"), config.SummaryOperation, "count", "sum", "min", "max")
+	if config.Type.IsUnknown() || config.Formula.IsUnknown() || resp.Diagnostics.HasError() {
+		return
+	}
+
+	fieldType := config.Type.ValueString()
+	formula := !config.Formula.IsNull()
+
+	configured := map[string]bool{
+		"default_value":           !config.DefaultValue.IsNull(),
+		"required":                config.Required.ValueBool(),
+		"unique":                  config.Unique.ValueBool(),
+		"external_id":             config.ExternalID.ValueBool(),
+		"track_history":           config.TrackHistory.ValueBool(),
+		"track_feed_history":      config.TrackFeedHistory.ValueBool(),
+		"length":                  !config.Length.IsNull(),
+		"precision":               !config.Precision.IsNull(),
+		"scale":                   !config.Scale.IsNull(),
+		"visible_lines":           !config.VisibleLines.IsNull(),
+		"picklist":                !config.Picklist.IsNull(),
+		"reference_to":            !config.ReferenceTo.IsNull(),
+		"relationship_name":       !config.RelationshipName.IsNull(),
+		"relationship_label":      !config.RelationshipLabel.IsNull(),
+		"delete_constraint":       !config.DeleteConstraint.IsNull(),
+		"formula":                 formula,
+		"formula_treat_blanks_as": !config.FormulaTreatBlanksAs.IsNull(),
+		"summary_operation":       !config.SummaryOperation.IsNull(),
+		"summary_foreign_key":     !config.SummaryForeignKey.IsNull(),
+		"summarized_field":        !config.SummarizedField.IsNull(),
+		"summary_filters":         !config.SummaryFilters.IsNull(),
+	}
+
+	for _, attribute := range sortedKeys(configured) {
+		if !configured[attribute] {
+			continue
+		}
+		if allowed, ok := customFieldAttributeTypes[attribute]; ok && !contains(allowed, fieldType) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attribute),
+				"Unsupported Custom Field Attribute",
+				fmt.Sprintf("%s fields do not support %s.", fieldType, attribute),
+			)
+		}
+		if formula && contains(customFieldNonFormulaAttributes, attribute) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attribute),
+				"Unsupported Custom Field Attribute",
+				fmt.Sprintf("Formula fields do not support %s.", attribute),
+			)
+		}
+	}
+	if configured["formula_treat_blanks_as"] && !formula {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("formula_treat_blanks_as"),
+			"Unsupported Custom Field Attribute",
+			"Only formula fields support formula_treat_blanks_as.",
+		)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	missing := func(attribute, reason string) {
+		if !configured[attribute] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attribute),
+				"Missing Custom Field Attribute",
+				fmt.Sprintf("%s fields need %s: %s", fieldType, attribute, reason),
+			)
+		}
+	}
+
+	switch fieldType {
+	case salesforce.CustomFieldTypeText:
+		if !formula {
+			missing("length", "the maximum number of characters, up to 255.")
+			validateInt64Between(&resp.Diagnostics, path.Root("length"), config.Length, 1, 255)
+		}
+	case salesforce.CustomFieldTypeLongTextArea:
+		missing("length", "the maximum number of characters, from 256 to 131072.")
+		missing("visible_lines", "the number of lines shown in forms.")
+		validateInt64Between(&resp.Diagnostics, path.Root("length"), config.Length, 256, 131072)
+	case salesforce.CustomFieldTypeNumber, salesforce.CustomFieldTypeCurrency, salesforce.CustomFieldTypePercent:
+		if !formula {
+			missing("precision", "the total number of digits, up to 18.")
+			validateInt64Between(&resp.Diagnostics, path.Root("precision"), config.Precision, 1, 18)
+		}
+		missing("scale", "the number of digits after the decimal point.")
+		validateInt64Between(&resp.Diagnostics, path.Root("scale"), config.Scale, 0, 18)
+		if !config.Precision.IsNull() && !config.Scale.IsNull() && config.Scale.ValueInt64() > config.Precision.ValueInt64() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("scale"),
+				"Invalid Attribute Value",
+				"The scale cannot be larger than the precision, which counts the digits after the decimal point too.",
+			)
+		}
+	case salesforce.CustomFieldTypeCheckbox:
+		if !formula {
+			missing("default_value", "\"true\" or \"false\".")
+			validateOneOf(&resp.Diagnostics, path.Root("default_value"), config.DefaultValue, "true", "false")
+		}
+	case salesforce.CustomFieldTypePicklist, salesforce.CustomFieldTypeMultiselectPicklist:
+		missing("picklist", "values or a global value set.")
+		if fieldType == salesforce.CustomFieldTypeMultiselectPicklist {
+			missing("visible_lines", "the number of lines shown in forms.")
+		}
+		if !config.Picklist.IsNull() && !config.Picklist.IsUnknown() {
+			var picklist customFieldPicklistConfigModel
+			resp.Diagnostics.Append(config.Picklist.As(ctx, &picklist, basetypes.ObjectAsOptions{})...)
+			resp.Diagnostics.Append(picklist.validate(fieldType)...)
+		}
+	case salesforce.CustomFieldTypeLookup, salesforce.CustomFieldTypeMasterDetail:
+		missing("reference_to", "the API name of the referenced object.")
+		missing("relationship_name", "the API name of the relationship.")
+		if config.Required.ValueBool() && config.DeleteConstraint.ValueString() == "SetNull" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("delete_constraint"),
+				"Invalid Attribute Value",
+				"Required lookup fields cannot be cleared when the referenced record is deleted, use Restrict or Cascade.",
+			)
+		}
+	case salesforce.CustomFieldTypeSummary:
+		missing("summary_operation", "count, sum, min or max.")
+		missing("summary_foreign_key", "the master-detail field of the summarized object.")
+		if !config.SummaryOperation.IsUnknown() && config.SummaryOperation.ValueString() != "count" {
+			missing("summarized_field", "the field to aggregate.")
+		}
+	}
+}
+
+// validate checks that the picklist has either values or a global value set
+// and, for single select picklists, at most one default value. Unknown
+// values are skipped.
+func (m customFieldPicklistConfigModel) validate(fieldType string) (diags diag.Diagnostics) {
+	picklistPath := path.Root("picklist")
+	if m.Values.IsNull() && m.ValueSetName.IsNull() {
+		diags.AddAttributeError(
+			picklistPath,
+			"Missing Picklist Values",
+			"A picklist needs either values or the value_set_name of a global value set.",
+		)
+	}
+	if !m.Values.IsNull() && !m.Values.IsUnknown() && !m.ValueSetName.IsNull() && !m.ValueSetName.IsUnknown() {
+		diags.AddAttributeError(
+			picklistPath.AtName("value_set_name"),
+			"Conflicting Picklist Values",
+			"A picklist has either values or a global value set, not both.",
+		)
+	}
+
+	defaults := 0
+	for _, element := range m.Values.Elements() {
+		value, ok := element.(types.Object)
+		if !ok || value.IsUnknown() {
+			continue
+		}
+		if isDefault, ok := value.Attributes()["default"].(types.Bool); ok && isDefault.ValueBool() {
+			defaults++
+		}
+	}
+	if fieldType == salesforce.CustomFieldTypePicklist && defaults > 1 {
+		diags.AddAttributeError(
+			picklistPath.AtName("values"),
+			"Multiple Picklist Default Values",
+			"A single select picklist can have only one default value.",
+		)
+	}
+
+	return diags
+}
+
+// ModifyPlan replaces the field when Salesforce cannot change it in place
+// and plans the computed attributes that do not apply to the planned type.
+func (r *customFieldResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when the field is destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, config customFieldResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.Type.IsUnknown() || plan.Formula.IsUnknown() || plan.ReferenceTo.IsUnknown() {
+		return
+	}
+
+	var state customFieldResourceModel
+	creating := req.State.Raw.IsNull()
+	if !creating {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	replacing := false
+	replace := func(attributePath path.Path, reason string) {
+		replacing = true
+		resp.RequiresReplace = append(resp.RequiresReplace, attributePath)
+		resp.Diagnostics.AddAttributeWarning(
+			attributePath,
+			"Salesforce Custom Field Will Be Replaced",
+			fmt.Sprintf("%s, so the field %s is deleted and created again. This deletes its values in all records.", reason, state.ID.ValueString()),
+		)
+	}
+	if !creating {
+		fromType, toType := state.Type.ValueString(), plan.Type.ValueString()
+		formula := !plan.Formula.IsNull()
+		switch {
+		case formula != !state.Formula.IsNull():
+			replace(path.Root("formula"), "Salesforce cannot turn a formula field into a field with values or back")
+		case fromType != toType && !formula && !contains(customFieldConversions[fromType], toType):
+			replace(path.Root("type"), fmt.Sprintf("Salesforce cannot change the type of a field from %s to %s", fromType, toType))
+		case !plan.ReferenceTo.Equal(state.ReferenceTo):
+			replace(path.Root("reference_to"), "Salesforce cannot change the object a relationship references")
+		}
+	}
+
+	// Computed attributes keep their value unless they do not apply anymore
+	fieldType := plan.Type.ValueString()
+	computed := []struct {
+		attribute string
+		config    types.String
+		state     types.String
+		plan      *types.String
+	}{
+		{"relationship_label", config.RelationshipLabel, state.RelationshipLabel, &plan.RelationshipLabel},
+		{"delete_constraint", config.DeleteConstraint, state.DeleteConstraint, &plan.DeleteConstraint},
+		{"formula_treat_blanks_as", config.FormulaTreatBlanksAs, state.FormulaTreatBlanksAs, &plan.FormulaTreatBlanksAs},
+	}
+	for _, attribute := range computed {
+		if !attribute.config.IsNull() {
+			continue
+		}
+		switch {
+		case !contains(customFieldAttributeTypes[attribute.attribute], fieldType):
+			*attribute.plan = types.StringNull()
+		case attribute.attribute == "formula_treat_blanks_as" && plan.Formula.IsNull():
+			*attribute.plan = types.StringNull()
+		case !creating && !replacing && !attribute.state.IsNull():
+			*attribute.plan = attribute.state
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *customFieldResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan customFieldResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(plan.Object.ValueString() + "." + plan.APIName.ValueString())
+
+	resp.Diagnostics.Append(r.planRelationshipLabel(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.SaveMetadata(ctx, plan.customField(), true)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Salesforce Custom Field",
+			err.Error(),
+		)
+		return
+	}
+	r.client.ForgetDescription(ctx, plan.Object.ValueString())
+
+	err = r.readComputed(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Salesforce Custom Field",
+			err.Error(),
+		)
+		return
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *customFieldResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state customFieldResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	field, err := r.client.GetCustomField(ctx, state.ID.ValueString())
+	if salesforce.IsNotFound(err) {
+		tflog.Warn(ctx, "Salesforce custom field no longer exists, removing it from state", map[string]any{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Salesforce Custom Field",
+			err.Error(),
+		)
+		return
+	}
+
+	state.update(field)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *customFieldResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan customFieldResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.planRelationshipLabel(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.SaveMetadata(ctx, plan.customField(), false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Salesforce Custom Field",
+			err.Error(),
+		)
+		return
+	}
+	r.client.ForgetDescription(ctx, plan.Object.ValueString())
+
+	err = r.readComputed(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Salesforce Custom Field",
+			err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *customFieldResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state customFieldResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fields already deleted outside of Terraform are gone as planned
+	err := r.client.DeleteComponent(ctx, "CustomField", state.ID.ValueString())
+	if err != nil && !salesforce.IsNotFound(err) && !salesforce.IsComponentNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Salesforce Custom Field",
+			err.Error(),
+		)
+		return
+	}
+	r.client.ForgetDescription(ctx, state.Object.ValueString())
+}
+
+// ImportState imports a custom field by its full name.
+func (r *customFieldResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	object, name, ok := strings.Cut(req.ID, ".")
+	if !ok || object == "" || name == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import Identifier",
+			fmt.Sprintf("Expected an identifier of the form Object.Field, e.g. Account.vub_Region__c, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("object"), object)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("api_name"), name)...)
+}
+
+// planRelationshipLabel defaults the label of the related list of a
+// relationship to the plural label of the object, as Salesforce needs one.
+func (r *customFieldResource) planRelationshipLabel(ctx context.Context, model *customFieldResourceModel) (diags diag.Diagnostics) {
+	if !model.RelationshipLabel.IsUnknown() {
+		return diags
+	}
+
+	description, err := r.client.GetDescription(ctx, model.Object.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Unable to Read Salesforce descriptions",
+			err.Error(),
+		)
+		return diags
+	}
+	model.RelationshipLabel = types.StringValue(description.LabelPlural)
+
+	return diags
+}
+
+// readComputed sets the computed attributes Salesforce chose for a saved
+// field.
+func (r *customFieldResource) readComputed(ctx context.Context, model *customFieldResourceModel) error {
+	field, err := r.client.GetCustomField(ctx, model.ID.ValueString())
+	if err != nil {
+		return err
+	}
+
+	if model.RelationshipLabel.IsUnknown() {
+		model.RelationshipLabel = optionalStringValue(field.RelationshipLabel)
+	}
+	if model.DeleteConstraint.IsUnknown() {
+		model.DeleteConstraint = optionalStringValue(field.DeleteConstraint)
+	}
+	if model.FormulaTreatBlanksAs.IsUnknown() {
+		model.FormulaTreatBlanksAs = optionalStringValue(field.FormulaTreatBlanksAs)
+	}
+
+	return nil
+}
+
+// customField converts the model into the Metadata API component.
+func (m customFieldResourceModel) customField() salesforce.CustomField {
+	field := salesforce.CustomField{
+		FullName:             m.ID.ValueString(),
+		DefaultValue:         m.DefaultValue.ValueString(),
+		DeleteConstraint:     m.DeleteConstraint.ValueString(),
+		Description:          m.Description.ValueString(),
+		ExternalID:           m.ExternalID.ValueBool(),
+		Formula:              m.Formula.ValueString(),
+		FormulaTreatBlanksAs: m.FormulaTreatBlanksAs.ValueString(),
+		InlineHelpText:       m.HelpText.ValueString(),
+		Label:                m.Label.ValueString(),
+		Length:               intPointer(m.Length),
+		Precision:            intPointer(m.Precision),
+		ReferenceTo:          m.ReferenceTo.ValueString(),
+		RelationshipLabel:    m.RelationshipLabel.ValueString(),
+		RelationshipName:     m.RelationshipName.ValueString(),
+		Required:             m.Required.ValueBool(),
+		Scale:                intPointer(m.Scale),
+		SummarizedField:      m.SummarizedField.ValueString(),
+		SummaryForeignKey:    m.SummaryForeignKey.ValueString(),
+		SummaryOperation:     m.SummaryOperation.ValueString(),
+		TrackFeedHistory:     m.TrackFeedHistory.ValueBool(),
+		TrackHistory:         m.TrackHistory.ValueBool(),
+		TrackTrending:        m.TrackTrending.ValueBool(),
+		Type:                 m.Type.ValueString(),
+		Unique:               m.Unique.ValueBool(),
+		VisibleLines:         intPointer(m.VisibleLines),
+	}
+
+	// Salesforce clears lookups of deleted records unless told otherwise,
+	// which required lookups do not allow
+	if m.Type.ValueString() == salesforce.CustomFieldTypeLookup && field.DeleteConstraint == "" && field.Required {
+		field.DeleteConstraint = "Restrict"
+	}
+
+	for _, filter := range m.SummaryFilters {
+		field.SummaryFilterItems = append(field.SummaryFilterItems, salesforce.FilterItem{
+			Field:     filter.Field.ValueString(),
+			Operation: filter.Operation.ValueString(),
+			Value:     filter.Value.ValueString(),
+		})
+	}
+
+	if m.Picklist != nil {
+		field.ValueSet = &salesforce.ValueSet{
+			ControllingField: m.Picklist.ControllingField.ValueString(),
+			Restricted:       m.Picklist.Restricted.ValueBool(),
+			ValueSetName:     m.Picklist.ValueSetName.ValueString(),
+		}
+		if m.Picklist.Values != nil {
+			definition := &salesforce.ValueSetDefinition{Sorted: m.Picklist.Sorted.ValueBool()}
+			for _, value := range m.Picklist.Values {
+				label := value.Label.ValueString()
+				if value.Label.IsNull() {
+					label = value.APIName.ValueString()
+				}
+				definition.Values = append(definition.Values, salesforce.CustomValue{
+					FullName: value.APIName.ValueString(),
+					Default:  value.Default.ValueBool(),
+					Label:    label,
+				})
+			}
+			field.ValueSet.ValueSetDefinition = definition
+		}
+	}
+
+	return field
+}
+
+// update sets the model to the field as read from Salesforce. Optional
+// values Salesforce fills in on its own stay null if they were null, so
+// they do not show up as drift.
+func (m *customFieldResourceModel) update(field *salesforce.CustomField) {
+	object, name, _ := strings.Cut(field.FullName, ".")
+	m.ID = types.StringValue(field.FullName)
+	m.Object = types.StringValue(object)
+	m.APIName = types.StringValue(name)
+	m.Label = types.StringValue(field.Label)
+	m.Type = types.StringValue(field.Type)
+	m.Description = optionalStringValue(field.Description)
+	m.HelpText = optionalStringValue(field.InlineHelpText)
+	m.DefaultValue = optionalStringValue(field.DefaultValue)
+	m.Required = types.BoolValue(field.Required)
+	m.Unique = types.BoolValue(field.Unique)
+	m.ExternalID = types.BoolValue(field.ExternalID)
+	m.TrackHistory = types.BoolValue(field.TrackHistory)
+	m.TrackFeedHistory = types.BoolValue(field.TrackFeedHistory)
+	m.TrackTrending = types.BoolValue(field.TrackTrending)
+	m.Length = int64PointerValue(field.Length)
+	m.VisibleLines = int64PointerValue(field.VisibleLines)
+	m.ReferenceTo = optionalStringValue(field.ReferenceTo)
+	m.RelationshipName = optionalStringValue(field.RelationshipName)
+	m.RelationshipLabel = optionalStringValue(field.RelationshipLabel)
+	m.DeleteConstraint = optionalStringValue(field.DeleteConstraint)
+	m.Formula = optionalStringValue(field.Formula)
+	m.FormulaTreatBlanksAs = optionalStringValue(field.FormulaTreatBlanksAs)
+	m.SummaryOperation = optionalStringValue(field.SummaryOperation)
+	m.SummaryForeignKey = optionalStringValue(field.SummaryForeignKey)
+	m.SummarizedField = optionalStringValue(field.SummarizedField)
+
+	// Salesforce sets the precision of numeric formulas and roll-up
+	// summaries, and the scale of roll-up summaries on its own
+	summary := field.Type == salesforce.CustomFieldTypeSummary
+	if (field.Formula == "" && !summary) || !m.Precision.IsNull() {
+		m.Precision = int64PointerValue(field.Precision)
+	}
+	if !summary || !m.Scale.IsNull() {
+		m.Scale = int64PointerValue(field.Scale)
+	}
+
+	m.SummaryFilters = nil
+	for _, item := range field.SummaryFilterItems {
+		m.SummaryFilters = append(m.SummaryFilters, customFieldFilterModel{
+			Field:     types.StringValue(item.Field),
+			Operation: types.StringValue(item.Operation),
+			Value:     optionalStringValue(item.Value),
+		})
+	}
+
+	m.Picklist = m.Picklist.update(field.ValueSet)
+}
+
+// update returns the picklist as read from Salesforce, keeping the
+// representation of the prior picklist m.
+func (m *customFieldPicklistModel) update(valueSet *salesforce.ValueSet) *customFieldPicklistModel {
+	if valueSet == nil {
+		return nil
+	}
+
+	prior := m
+	if prior == nil {
+		prior = &customFieldPicklistModel{}
+	}
+	priorValues := map[string]customFieldPicklistValueModel{}
+	for _, value := range prior.Values {
+		priorValues[value.APIName.ValueString()] = value
+	}
+
+	picklist := &customFieldPicklistModel{
+		Restricted:       optionalBoolValue(valueSet.Restricted, prior.Restricted),
+		Sorted:           types.BoolNull(),
+		ValueSetName:     optionalStringValue(valueSet.ValueSetName),
+		ControllingField: optionalStringValue(valueSet.ControllingField),
+	}
+	if definition := valueSet.ValueSetDefinition; definition != nil {
+		picklist.Sorted = optionalBoolValue(definition.Sorted, prior.Sorted)
+		picklist.Values = []customFieldPicklistValueModel{}
+		for _, value := range definition.Values {
+			priorValue, ok := priorValues[value.FullName]
+			label := types.StringValue(value.Label)
+			if value.Label == value.FullName && (!ok || priorValue.Label.IsNull()) {
+				label = types.StringNull()
+			}
+			picklist.Values = append(picklist.Values, customFieldPicklistValueModel{
+				APIName: types.StringValue(value.FullName),
+				Label:   label,
+				Default: optionalBoolValue(value.Default, priorValue.Default),
+			})
+		}
+	}
+
+	return picklist
+}
+
+// contains reports whether values contains value.
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,318 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/villeroy-boch/terraform-provider-salesforce/internal/salesforce"
+)
+
+func TestAccCustomFieldResource(t *testing.T) {
+	org := newMockSalesforce(t)
+
+	object := `resource "salesforce_custom_object" "training" {
+		api_name     = "vub_Training__c"
+		label        = "Training"
+		plural_label = "Trainings"
+	}
+	`
+	level := `resource "salesforce_custom_field" "level" {
+		object   = salesforce_custom_object.training.api_name
+		api_name = "vub_Level__c"
+		label    = "Level"
+		type     = "Picklist"
+		picklist = {
+			values = [
+				{ api_name = "Beginner" },
+				{ api_name = "Expert", label = "Expert level", default = true },
+			]
+		}
+	}
+	`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			org.exists("CustomObject", "vub_Training__c", false),
+			org.exists("CustomField", "vub_Training__c.vub_Code__c", false),
+			org.exists("CustomField", "vub_Training__c.vub_Level__c", false),
+		),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: org.providerConfig() + object + level + `resource "salesforce_custom_field" "code" {
+					object   = salesforce_custom_object.training.api_name
+					api_name = "vub_Code__c"
+					label    = "Code"
+					type     = "Text"
+					length   = 20
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("salesforce_custom_field.code", "id", "vub_Training__c.vub_Code__c"),
+					resource.TestCheckResourceAttr("salesforce_custom_field.code", "length", "20"),
+					resource.TestCheckResourceAttr("salesforce_custom_field.code", "required", "false"),
+					resource.TestCheckNoResourceAttr("salesforce_custom_field.code", "relationship_label"),
+					resource.TestCheckNoResourceAttr("salesforce_custom_field.code", "delete_constraint"),
+					resource.TestCheckResourceAttr("salesforce_custom_field.level", "id", "vub_Training__c.vub_Level__c"),
+					resource.TestCheckResourceAttr("salesforce_custom_field.level", "picklist.values.#", "2"),
+					org.exists("CustomField", "vub_Training__c.vub_Code__c", true),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "salesforce_custom_field.code",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "salesforce_custom_field.level",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: org.providerConfig() + object + `resource "salesforce_custom_field" "level" {
+					object   = salesforce_custom_object.training.api_name
+					api_name = "vub_Level__c"
+					label    = "Level"
+					type     = "Picklist"
+					picklist = {
+						values = [
+							{ api_name = "Beginner" },
+							{ api_name = "Intermediate" },
+							{ api_name = "Expert", label = "Expert level", default = true },
+						]
+					}
+				}
+				resource "salesforce_custom_field" "code" {
+					object      = salesforce_custom_object.training.api_name
+					api_name    = "vub_Code__c"
+					label       = "Course Code"
+					type        = "Text"
+					length      = 40
+					description = "Code printed on certificates."
+				}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("salesforce_custom_field.code", plancheck.ResourceActionUpdate),
+						plancheck.ExpectResourceAction("salesforce_custom_field.level", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("salesforce_custom_field.code", "label", "Course Code"),
+					resource.TestCheckResourceAttr("salesforce_custom_field.code", "description", "Code printed on certificates."),
+					resource.TestCheckResourceAttr("salesforce_custom_field.level", "picklist.values.#", "3"),
+					resource.TestCheckResourceAttr("salesforce_custom_field.level", "picklist.values.1.api_name", "Intermediate"),
+					func(*terraform.State) error {
+						var field salesforce.CustomField
+						if !org.component("CustomField", "vub_Training__c.vub_Code__c", &field) {
+							return fmt.Errorf("custom field vub_Training__c.vub_Code__c does not exist")
+						}
+						if field.Label != "Course Code" || field.Length == nil || *field.Length != 40 {
+							return fmt.Errorf("custom field was not updated: %+v", field)
+						}
+						return nil
+					},
+				),
+			},
+			// Types Salesforce converts in place update the field
+			{
+				Config: org.providerConfig() + object + level + `resource "salesforce_custom_field" "code" {
+					object   = salesforce_custom_object.training.api_name
+					api_name = "vub_Code__c"
+					label    = "Course Code"
+					type     = "TextArea"
+				}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("salesforce_custom_field.code", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("salesforce_custom_field.code", "type", "TextArea"),
+					resource.TestCheckNoResourceAttr("salesforce_custom_field.code", "length"),
+				),
+			},
+			// Other type changes replace the field
+			{
+				Config: org.providerConfig() + object + level + `resource "salesforce_custom_field" "code" {
+					object        = salesforce_custom_object.training.api_name
+					api_name      = "vub_Code__c"
+					label         = "Certified"
+					type          = "Checkbox"
+					default_value = "false"
+				}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("salesforce_custom_field.code", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("salesforce_custom_field.code", "type", "Checkbox"),
+					resource.TestCheckResourceAttr("salesforce_custom_field.code", "default_value", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestCustomFieldResourceValidateConfig(t *testing.T) {
+	// picklist returns a picklist with values, either a tftypes value or the
+	// values as API names with whether they are the default.
+	picklist := func(values any, valueSetName any) func(tftypes.Type) tftypes.Value {
+		return func(picklistType tftypes.Type) tftypes.Value {
+			attributeTypes := picklistType.(tftypes.Object).AttributeTypes
+			if defaults, ok := values.(map[string]bool); ok {
+				valueType := attributeTypes["values"].(tftypes.List).ElementType
+				elements := []tftypes.Value{}
+				for _, apiName := range sortedKeys(defaults) {
+					elements = append(elements, tftypes.NewValue(valueType, map[string]tftypes.Value{
+						"api_name": tftypes.NewValue(tftypes.String, apiName),
+						"label":    tftypes.NewValue(tftypes.String, nil),
+						"default":  tftypes.NewValue(tftypes.Bool, defaults[apiName]),
+					}))
+				}
+				values = elements
+			}
+			return tftypes.NewValue(picklistType, map[string]tftypes.Value{
+				"restricted":        tftypes.NewValue(tftypes.Bool, nil),
+				"sorted":            tftypes.NewValue(tftypes.Bool, nil),
+				"value_set_name":    tftypes.NewValue(tftypes.String, valueSetName),
+				"controlling_field": tftypes.NewValue(tftypes.String, nil),
+				"values":            tftypes.NewValue(attributeTypes["values"], values),
+			})
+		}
+	}
+
+	tests := map[string]struct {
+		values  map[string]any
+		wantErr string
+	}{
+		"unknown picklist": {
+			values: map[string]any{"type": "Picklist", "picklist": tftypes.UnknownValue},
+		},
+		"unknown picklist values": {
+			values: map[string]any{"type": "Picklist", "picklist": picklist(tftypes.UnknownValue, nil)},
+		},
+		"unknown picklist values with value set": {
+			values: map[string]any{"type": "Picklist", "picklist": picklist(tftypes.UnknownValue, "vub_Levels")},
+		},
+		"picklist without values": {
+			values:  map[string]any{"type": "Picklist", "picklist": picklist(nil, nil)},
+			wantErr: "Missing Picklist Values",
+		},
+		"multiple picklist defaults": {
+			values:  map[string]any{"type": "Picklist", "picklist": picklist(map[string]bool{"Beginner": true, "Expert": true}, nil)},
+			wantErr: "Multiple Picklist Default Values",
+		},
+		"text without length": {
+			values:  map[string]any{"type": "Text"},
+			wantErr: "Missing Custom Field Attribute",
+		},
+		"precision of text": {
+			values:  map[string]any{"type": "Text", "length": 20, "precision": 4},
+			wantErr: "Unsupported Custom Field Attribute",
+		},
+		"required formula": {
+			values:  map[string]any{"type": "Date", "formula": "TODAY()", "required": true},
+			wantErr: "Unsupported Custom Field Attribute",
+		},
+		"summary without foreign key": {
+			values:  map[string]any{"type": "Summary", "summary_operation": "sum"},
+			wantErr: "Missing Custom Field Attribute",
+		},
+		"API name without suffix": {
+			values:  map[string]any{"type": "Text", "length": 20, "api_name": "vub_Code"},
+			wantErr: "Invalid Custom Field API Name",
+		},
+		"unknown summary filters": {
+			values: map[string]any{
+				"type":                "Summary",
+				"summary_operation":   "count",
+				"summary_foreign_key": "vub_Booking__c.vub_Training__c",
+				"summary_filters":     tftypes.UnknownValue,
+			},
+		},
+		"summary filters of text field": {
+			values: map[string]any{
+				"type":            "Text",
+				"length":          20,
+				"summary_filters": tftypes.UnknownValue,
+			},
+			wantErr: "Unsupported Custom Field Attribute",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			values := map[string]any{"object": "vub_Training__c", "api_name": "vub_Level__c", "label": "Level"}
+			for attribute, value := range test.values {
+				values[attribute] = value
+			}
+
+			diags := validateResourceConfig(t, &customFieldResource{}, values)
+
+			if test.wantErr == "" && diags.HasError() {
+				t.Fatalf("ValidateConfig() diagnostics = %v", diags)
+			}
+			if test.wantErr != "" && (!diags.HasError() || diags.Errors()[0].Summary() != test.wantErr) {
+				t.Fatalf("ValidateConfig() diagnostics = %v, want %q", diags, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestCustomFieldConversions(t *testing.T) {
+	tests := []struct {
+		from, to string
+		want     bool
+	}{
+		{salesforce.CustomFieldTypeText, salesforce.CustomFieldTypeLongTextArea, true},
+		{salesforce.CustomFieldTypeText, salesforce.CustomFieldTypeCheckbox, false},
+		{salesforce.CustomFieldTypeLongTextArea, salesforce.CustomFieldTypeEmail, false},
+		{salesforce.CustomFieldTypeNumber, salesforce.CustomFieldTypeCurrency, true},
+		{salesforce.CustomFieldTypeNumber, salesforce.CustomFieldTypeDate, false},
+		{salesforce.CustomFieldTypeDate, salesforce.CustomFieldTypeDateTime, true},
+		{salesforce.CustomFieldTypePicklist, salesforce.CustomFieldTypeMultiselectPicklist, true},
+		{salesforce.CustomFieldTypeLookup, salesforce.CustomFieldTypeMasterDetail, true},
+		{salesforce.CustomFieldTypeLookup, salesforce.CustomFieldTypeText, false},
+		{salesforce.CustomFieldTypeCheckbox, salesforce.CustomFieldTypeText, false},
+		{salesforce.CustomFieldTypeSummary, salesforce.CustomFieldTypeNumber, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.from+" to "+test.to, func(t *testing.T) {
+			if got := contains(customFieldConversions[test.from], test.to); got != test.want {
+				t.Errorf("conversion from %s to %s in place = %t, want %t", test.from, test.to, got, test.want)
+			}
+		})
+	}
+
+	// Conversions must stay within the types the resource manages
+	for _, from := range sortedKeys(customFieldConversions) {
+		for _, to := range customFieldConversions[from] {
+			if !contains(customFieldTypes, from) || !contains(customFieldTypes, to) || from == to {
+				t.Errorf("invalid conversion from %s to %s", from, to)
+			}
+		}
+	}
+}
+
+func TestCustomFieldResourceDeleteMissing(t *testing.T) {
+	org := newMockSalesforce(t)
+
+	diags := deleteResource(t, &customFieldResource{client: org.client(t)}, map[string]any{
+		"id":       "vub_Training__c.vub_Code__c",
+		"object":   "vub_Training__c",
+		"api_name": "vub_Code__c",
+	})
+	if diags.HasError() {
+		t.Errorf("Delete() diagnostics = %v", diags)
+	}
+}
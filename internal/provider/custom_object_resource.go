@@ -0,0 +1,421 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/villeroy-boch/terraform-provider-salesforce/internal/salesforce"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &customObjectResource{}
+	_ resource.ResourceWithConfigure      = &customObjectResource{}
+	_ resource.ResourceWithImportState    = &customObjectResource{}
+	_ resource.ResourceWithValidateConfig = &customObjectResource{}
+)
+
+// Types of the name field of custom objects.
+const (
+	nameFieldTypeText       = "Text"
+	nameFieldTypeAutoNumber = "AutoNumber"
+)
+
+// NewCustomObjectResource is a helper function to simplify the provider implementation.
+func NewCustomObjectResource() resource.Resource {
+	return &customObjectResource{}
+}
+
+// customObjectResource is the resource implementation.
+type customObjectResource struct {
+	client *salesforce.Client
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *customObjectResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring Salesforce Custom Object resource")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*salesforce.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *salesforce.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+
+	tflog.Info(ctx, "Configured Salesforce Custom Object resource", map[string]any{"success": true})
+}
+
+// Metadata returns the resource type name.
+func (r *customObjectResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_object"
+}
+
+// Schema defines the schema for the resource.
+func (r *customObjectResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a custom object through the Metadata API. " +
+			"Deleted custom objects stay in the recycle bin for 15 days, during which their API name cannot be used again.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "API name of the custom object.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"api_name": schema.StringAttribute{
+				Description: "API name of the custom object including the `__c` suffix, e.g. `vub_Training__c`. Changing it replaces the object.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"label": schema.StringAttribute{
+				Description: "Label of the custom object.",
+				Required:    true,
+			},
+			"plural_label": schema.StringAttribute{
+				Description: "Plural label of the custom object.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the custom object.",
+				Optional:    true,
+			},
+			"name_field_label": schema.StringAttribute{
+				Description: "Label of the name field. Defaults to `Name`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("Name"),
+			},
+			"name_field_type": schema.StringAttribute{
+				Description: "Type of the name field, either `Text` or `AutoNumber`. Defaults to `Text`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(nameFieldTypeText),
+			},
+			"name_field_format": schema.StringAttribute{
+				Description: "Display format of an `AutoNumber` name field, e.g. `T-{0000}`.",
+				Optional:    true,
+			},
+			"sharing_model": schema.StringAttribute{
+				Description: "Organization-wide default access to records, one of `Private`, `Read`, `ReadWrite` or `ControlledByParent`. Defaults to `ReadWrite`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(salesforce.SharingModelReadWrite),
+			},
+			"deployment_status": schema.StringAttribute{
+				Description: "Either `Deployed` or `InDevelopment`, which hides the object from users without the Customize Application permission. Defaults to `Deployed`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(salesforce.DeploymentStatusDeployed),
+			},
+			"enable_activities": customObjectFlagAttribute("Whether tasks and events can be related to records."),
+			"enable_history":    customObjectFlagAttribute("Whether field history can be tracked."),
+			"enable_reports":    customObjectFlagAttribute("Whether records can be reported on."),
+			"enable_search":     customObjectFlagAttribute("Whether records can be found by search."),
+			"enable_bulk_api": customObjectFlagAttribute("Whether records can be accessed through the Bulk API. " +
+				"Must be equal to `enable_sharing` and `enable_streaming_api`."),
+			"enable_sharing": customObjectFlagAttribute("Whether records can be shared. " +
+				"Must be equal to `enable_bulk_api` and `enable_streaming_api`."),
+			"enable_streaming_api": customObjectFlagAttribute("Whether records can be accessed through the Streaming API. " +
+				"Must be equal to `enable_bulk_api` and `enable_sharing`."),
+		},
+	}
+}
+
+// customObjectFlagAttribute returns the schema of a setting of a custom
+// object that is off by default.
+func customObjectFlagAttribute(description string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Description: description + " Defaults to `false`.",
+		Optional:    true,
+		Computed:    true,
+		Default:     booldefault.StaticBool(false),
+	}
+}
+
+// customObjectResourceModel maps the resource schema data.
+type customObjectResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	APIName            types.String `tfsdk:"api_name"`
+	Label              types.String `tfsdk:"label"`
+	PluralLabel        types.String `tfsdk:"plural_label"`
+	Description        types.String `tfsdk:"description"`
+	NameFieldLabel     types.String `tfsdk:"name_field_label"`
+	NameFieldType      types.String `tfsdk:"name_field_type"`
+	NameFieldFormat    types.String `tfsdk:"name_field_format"`
+	SharingModel       types.String `tfsdk:"sharing_model"`
+	DeploymentStatus   types.String `tfsdk:"deployment_status"`
+	EnableActivities   types.Bool   `tfsdk:"enable_activities"`
+	EnableHistory      types.Bool   `tfsdk:"enable_history"`
+	EnableReports      types.Bool   `tfsdk:"enable_reports"`
+	EnableSearch       types.Bool   `tfsdk:"enable_search"`
+	EnableBulkAPI      types.Bool   `tfsdk:"enable_bulk_api"`
+	EnableSharing      types.Bool   `tfsdk:"enable_sharing"`
+	EnableStreamingAPI types.Bool   `tfsdk:"enable_streaming_api"`
+}
+
+// ValidateConfig checks the combinations of settings the Metadata API
+// rejects.
+func (r *customObjectResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config customObjectResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.APIName.IsUnknown() && !strings.HasSuffix(config.APIName.ValueString(), "__c") {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_name"),
+			"Invalid Custom Object API Name",
+			fmt.Sprintf("The API name of a custom object must end with __c, got: %q", config.APIName.ValueString()),
+		)
+	}
+
+	validateOneOf(&resp.Diagnostics, path.Root("name_field_type"), config.NameFieldType, nameFieldTypeText, nameFieldTypeAutoNumber)
+	validateOneOf(&resp.Diagnostics, path.Root("sharing_model"), config.SharingModel,
+		salesforce.SharingModelPrivate, salesforce.SharingModelRead, salesforce.SharingModelReadWrite, salesforce.SharingModelControlledByParent)
+	validateOneOf(&resp.Diagnostics, path.Root("deployment_status"), config.DeploymentStatus,
+		salesforce.DeploymentStatusDeployed, salesforce.DeploymentStatusInDevelopment)
+
+	if config.NameFieldType.ValueString() == nameFieldTypeAutoNumber && config.NameFieldFormat.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name_field_format"),
+			"Missing Name Field Format",
+			"An AutoNumber name field needs a display format such as \"T-{0000}\".",
+		)
+	}
+	if config.NameFieldType.ValueString() != nameFieldTypeAutoNumber && !config.NameFieldType.IsUnknown() && !config.NameFieldFormat.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name_field_format"),
+			"Unexpected Name Field Format",
+			"Only AutoNumber name fields have a display format.",
+		)
+	}
+
+	// Salesforce enables these as a group, the Enterprise Application settings
+	flags := []types.Bool{config.EnableBulkAPI, config.EnableSharing, config.EnableStreamingAPI}
+	for _, flag := range flags[1:] {
+		if flag.IsUnknown() || flags[0].IsUnknown() {
+			continue
+		}
+		if flag.ValueBool() != flags[0].ValueBool() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("enable_bulk_api"),
+				"Inconsistent Custom Object Settings",
+				"Salesforce requires enable_bulk_api, enable_sharing and enable_streaming_api to be either all true or all false.",
+			)
+			break
+		}
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *customObjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan customObjectResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.SaveMetadata(ctx, plan.customObject(), true)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Salesforce Custom Object",
+			err.Error(),
+		)
+		return
+	}
+	r.client.ForgetDescription(ctx, plan.APIName.ValueString())
+
+	plan.ID = plan.APIName
+
+	// Set state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data. Labels come from
+// the describe endpoint, the settings it lacks from the Metadata API.
+func (r *customObjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state customObjectResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiName := state.APIName.ValueString()
+	if apiName == "" {
+		apiName = state.ID.ValueString()
+	}
+
+	object, err := r.client.GetCustomObject(ctx, apiName)
+	if salesforce.IsNotFound(err) {
+		tflog.Warn(ctx, "Salesforce custom object no longer exists, removing it from state", map[string]any{"api_name": apiName})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Salesforce Custom Object",
+			err.Error(),
+		)
+		return
+	}
+
+	description, err := r.client.GetDescription(ctx, apiName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Salesforce descriptions",
+			err.Error(),
+		)
+		return
+	}
+
+	state.ID = types.StringValue(object.FullName)
+	state.APIName = types.StringValue(description.Name)
+	state.Label = types.StringValue(description.Label)
+	state.PluralLabel = types.StringValue(description.LabelPlural)
+	state.Description = optionalStringValue(object.Description)
+	state.NameFieldType = types.StringValue(nameFieldTypeText)
+	state.NameFieldFormat = types.StringNull()
+	for _, field := range description.Fields {
+		if !field.NameField {
+			continue
+		}
+		state.NameFieldLabel = types.StringValue(field.Label)
+		if field.AutoNumber {
+			state.NameFieldType = types.StringValue(nameFieldTypeAutoNumber)
+		}
+	}
+	if object.NameField != nil && object.NameField.DisplayFormat != "" {
+		state.NameFieldFormat = types.StringValue(object.NameField.DisplayFormat)
+	}
+	state.SharingModel = types.StringValue(object.SharingModel)
+	state.DeploymentStatus = types.StringValue(object.DeploymentStatus)
+	state.EnableActivities = types.BoolValue(object.EnableActivities)
+	state.EnableHistory = types.BoolValue(object.EnableHistory)
+	state.EnableReports = types.BoolValue(object.EnableReports)
+	state.EnableSearch = types.BoolValue(object.EnableSearch)
+	state.EnableBulkAPI = types.BoolValue(object.EnableBulkAPI)
+	state.EnableSharing = types.BoolValue(object.EnableSharing)
+	state.EnableStreamingAPI = types.BoolValue(object.EnableStreamingAPI)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *customObjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan customObjectResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.SaveMetadata(ctx, plan.customObject(), false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Salesforce Custom Object",
+			err.Error(),
+		)
+		return
+	}
+	r.client.ForgetDescription(ctx, plan.APIName.ValueString())
+
+	plan.ID = plan.APIName
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *customObjectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state customObjectResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Objects already deleted outside of Terraform are gone as planned
+	err := r.client.DeleteComponent(ctx, "CustomObject", state.APIName.ValueString())
+	if err != nil && !salesforce.IsNotFound(err) && !salesforce.IsComponentNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Salesforce Custom Object",
+			err.Error(),
+		)
+		return
+	}
+	r.client.ForgetDescription(ctx, state.APIName.ValueString())
+}
+
+// ImportState imports a custom object by its API name.
+func (r *customObjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("api_name"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// customObject converts the model into the Metadata API component.
+func (m customObjectResourceModel) customObject() salesforce.CustomObject {
+	nameField := &salesforce.CustomObjectNameField{
+		Label: m.NameFieldLabel.ValueString(),
+		Type:  m.NameFieldType.ValueString(),
+	}
+	if m.NameFieldType.ValueString() == nameFieldTypeAutoNumber {
+		nameField.DisplayFormat = m.NameFieldFormat.ValueString()
+	}
+
+	return salesforce.CustomObject{
+		FullName:           m.APIName.ValueString(),
+		DeploymentStatus:   m.DeploymentStatus.ValueString(),
+		Description:        m.Description.ValueString(),
+		EnableActivities:   m.EnableActivities.ValueBool(),
+		EnableBulkAPI:      m.EnableBulkAPI.ValueBool(),
+		EnableHistory:      m.EnableHistory.ValueBool(),
+		EnableReports:      m.EnableReports.ValueBool(),
+		EnableSearch:       m.EnableSearch.ValueBool(),
+		EnableSharing:      m.EnableSharing.ValueBool(),
+		EnableStreamingAPI: m.EnableStreamingAPI.ValueBool(),
+		Label:              m.Label.ValueString(),
+		NameField:          nameField,
+		PluralLabel:        m.PluralLabel.ValueString(),
+		SharingModel:       m.SharingModel.ValueString(),
+	}
+}
@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/villeroy-boch/terraform-provider-salesforce/internal/salesforce"
+)
+
+func TestAccCustomObjectResource(t *testing.T) {
+	org := newMockSalesforce(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             org.exists("CustomObject", "vub_Training__c", false),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: org.providerConfig() + `resource "salesforce_custom_object" "test" {
+					api_name     = "vub_Training__c"
+					label        = "Training"
+					plural_label = "Trainings"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("salesforce_custom_object.test", "id", "vub_Training__c"),
+					resource.TestCheckResourceAttr("salesforce_custom_object.test", "name_field_label", "Name"),
+					resource.TestCheckResourceAttr("salesforce_custom_object.test", "name_field_type", "Text"),
+					resource.TestCheckNoResourceAttr("salesforce_custom_object.test", "name_field_format"),
+					resource.TestCheckResourceAttr("salesforce_custom_object.test", "sharing_model", "ReadWrite"),
+					resource.TestCheckResourceAttr("salesforce_custom_object.test", "deployment_status", "Deployed"),
+					resource.TestCheckResourceAttr("salesforce_custom_object.test", "enable_reports", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "salesforce_custom_object.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: org.providerConfig() + `resource "salesforce_custom_object" "test" {
+					api_name          = "vub_Training__c"
+					label             = "Course"
+					plural_label      = "Courses"
+					description       = "Courses offered to customers."
+					name_field_type   = "AutoNumber"
+					name_field_format = "C-{0000}"
+					sharing_model     = "Private"
+					enable_reports    = true
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("salesforce_custom_object.test", "label", "Course"),
+					resource.TestCheckResourceAttr("salesforce_custom_object.test", "description", "Courses offered to customers."),
+					resource.TestCheckResourceAttr("salesforce_custom_object.test", "name_field_format", "C-{0000}"),
+					resource.TestCheckResourceAttr("salesforce_custom_object.test", "enable_reports", "true"),
+					func(*terraform.State) error {
+						var object salesforce.CustomObject
+						if !org.component("CustomObject", "vub_Training__c", &object) {
+							return fmt.Errorf("custom object vub_Training__c does not exist")
+						}
+						if object.Label != "Course" || object.NameField == nil || object.NameField.Type != "AutoNumber" || object.SharingModel != "Private" {
+							return fmt.Errorf("custom object was not updated: %+v", object)
+						}
+						return nil
+					},
+				),
+			},
+			// ImportState testing of the updated object
+			{
+				ResourceName:      "salesforce_custom_object.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestCustomObjectResourceValidateConfig(t *testing.T) {
+	tests := map[string]struct {
+		values  map[string]any
+		wantErr string
+	}{
+		"valid": {},
+		"unknown API name": {
+			values: map[string]any{"api_name": tftypes.UnknownValue},
+		},
+		"API name without suffix": {
+			values:  map[string]any{"api_name": "vub_Training"},
+			wantErr: "Invalid Custom Object API Name",
+		},
+		"AutoNumber without format": {
+			values:  map[string]any{"name_field_type": "AutoNumber"},
+			wantErr: "Missing Name Field Format",
+		},
+		"format of a Text name field": {
+			values:  map[string]any{"name_field_type": "Text", "name_field_format": "T-{0000}"},
+			wantErr: "Unexpected Name Field Format",
+		},
+		"invalid sharing model": {
+			values:  map[string]any{"sharing_model": "Public"},
+			wantErr: "Invalid Attribute Value",
+		},
+		"only bulk API enabled": {
+			values:  map[string]any{"enable_bulk_api": true},
+			wantErr: "Inconsistent Custom Object Settings",
+		},
+		"unknown bulk API": {
+			values: map[string]any{"enable_bulk_api": tftypes.UnknownValue, "enable_sharing": true},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			values := map[string]any{"api_name": "vub_Training__c", "label": "Training", "plural_label": "Trainings"}
+			for attribute, value := range test.values {
+				values[attribute] = value
+			}
+
+			diags := validateResourceConfig(t, &customObjectResource{}, values)
+
+			if test.wantErr == "" && diags.HasError() {
+				t.Fatalf("ValidateConfig() diagnostics = %v", diags)
+			}
+			if test.wantErr != "" && (!diags.HasError() || diags.Errors()[0].Summary() != test.wantErr) {
+				t.Fatalf("ValidateConfig() diagnostics = %v, want %q", diags, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestCustomObjectResourceDeleteMissing(t *testing.T) {
+	org := newMockSalesforce(t)
+
+	diags := deleteResource(t, &customObjectResource{client: org.client(t)}, map[string]any{
+		"id":       "vub_Training__c",
+		"api_name": "vub_Training__c",
+	})
+	if diags.HasError() {
+		t.Errorf("Delete() diagnostics = %v", diags)
+	}
+}
@@ -6,6 +6,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -58,58 +60,288 @@ func (d *descriptionDataSource) Metadata(_ context.Context, req datasource.Metad
 
 // Schema defines the schema for the data source.
 func (d *descriptionDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := descriptionAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Description: "API name of the described object.",
+		Computed:    true,
+	}
+	attributes["name"] = schema.StringAttribute{
+		Description: "Name of the described object.",
+		Required:    true,
+	}
+	attributes["field_names"] = schema.ListAttribute{
+		ElementType: types.StringType,
+		Description: "API names of the fields to return, matched case-insensitively. Defaults to all fields of the object.",
+		Optional:    true,
+	}
 
 	resp.Schema = schema.Schema{
 		Description: "Fetches a description.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				Description: "Placeholder identifier attribute.",
-				Computed:    true,
+		Attributes:  attributes,
+	}
+}
+
+// descriptionAttributes returns the schema of a described object without the
+// attributes that select it.
+func descriptionAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"label": schema.StringAttribute{
+			Description: "Label of the described object.",
+			Computed:    true,
+		},
+		"label_plural": schema.StringAttribute{
+			Description: "Plural label of the described object.",
+			Computed:    true,
+		},
+		"key_prefix": schema.StringAttribute{
+			Description: "Three character prefix of record IDs of the described object.",
+			Computed:    true,
+		},
+		"custom": schema.BoolAttribute{
+			Description: "Whether the object is a custom object.",
+			Computed:    true,
+		},
+		"custom_setting": schema.BoolAttribute{
+			Description: "Whether the object is a custom setting.",
+			Computed:    true,
+		},
+		"createable": schema.BoolAttribute{
+			Description: "Whether records of the object can be created.",
+			Computed:    true,
+		},
+		"updateable": schema.BoolAttribute{
+			Description: "Whether records of the object can be updated.",
+			Computed:    true,
+		},
+		"deletable": schema.BoolAttribute{
+			Description: "Whether records of the object can be deleted.",
+			Computed:    true,
+		},
+		"undeletable": schema.BoolAttribute{
+			Description: "Whether deleted records of the object can be restored.",
+			Computed:    true,
+		},
+		"queryable": schema.BoolAttribute{
+			Description: "Whether the object can be queried.",
+			Computed:    true,
+		},
+		"retrieveable": schema.BoolAttribute{
+			Description: "Whether records of the object can be retrieved by ID.",
+			Computed:    true,
+		},
+		"searchable": schema.BoolAttribute{
+			Description: "Whether the object can be searched.",
+			Computed:    true,
+		},
+		"mergeable": schema.BoolAttribute{
+			Description: "Whether records of the object can be merged.",
+			Computed:    true,
+		},
+		"triggerable": schema.BoolAttribute{
+			Description: "Whether Apex triggers can be defined on the object.",
+			Computed:    true,
+		},
+		"feed_enabled": schema.BoolAttribute{
+			Description: "Whether Chatter feeds are enabled for the object.",
+			Computed:    true,
+		},
+		"fields": schema.ListNestedAttribute{
+			Description: "Fields of the described object.",
+			Computed:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: descriptionFieldAttributes(),
 			},
-			"name": schema.StringAttribute{
-				Description: "Name of the described object.",
-				Required:    true,
+		},
+		"fields_by_name": schema.MapNestedAttribute{
+			Description: "Fields of the described object by their API name as spelled by Salesforce. Unlike `field_names`, the keys are case-sensitive.",
+			Computed:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: descriptionFieldAttributes(),
 			},
-			"label": schema.StringAttribute{
-				Description: "Label of the described object.",
-				Computed:    true,
+		},
+		"child_relationships": schema.ListNestedAttribute{
+			Description: "Relationships of other objects to the described object.",
+			Computed:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"child_sobject": schema.StringAttribute{
+						Description: "Name of the object that refers to the described object.",
+						Computed:    true,
+					},
+					"field": schema.StringAttribute{
+						Description: "Field of the child object that holds the reference.",
+						Computed:    true,
+					},
+					"relationship_name": schema.StringAttribute{
+						Description: "Name of the relationship, used in queries of the described object.",
+						Computed:    true,
+					},
+					"cascade_delete": schema.BoolAttribute{
+						Description: "Whether deleting a record deletes its child records.",
+						Computed:    true,
+					},
+					"restricted_delete": schema.BoolAttribute{
+						Description: "Whether records with child records cannot be deleted.",
+						Computed:    true,
+					},
+					"deprecated_and_hidden": schema.BoolAttribute{
+						Description: "Whether the relationship is deprecated.",
+						Computed:    true,
+					},
+				},
 			},
-			"fields": schema.ListNestedAttribute{
-				Description: "Fields of the described object.",
-				Computed:    true,
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"name": schema.StringAttribute{
-							Description: "Name of the field.",
-							Computed:    true,
-						},
-						"label": schema.StringAttribute{
-							Description: "Label of the field.",
-							Computed:    true,
-						},
-						"type": schema.StringAttribute{
-							Description: "Type of the field.",
-							Computed:    true,
-						},
+		},
+		"record_types": schema.ListNestedAttribute{
+			Description: "Record types of the described object.",
+			Computed:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Description: "Label of the record type.",
+						Computed:    true,
+					},
+					"developer_name": schema.StringAttribute{
+						Description: "API name of the record type.",
+						Computed:    true,
+					},
+					"record_type_id": schema.StringAttribute{
+						Description: "ID of the record type.",
+						Computed:    true,
+					},
+					"active": schema.BoolAttribute{
+						Description: "Whether the record type is active.",
+						Computed:    true,
+					},
+					"available": schema.BoolAttribute{
+						Description: "Whether the record type is available to the configured user.",
+						Computed:    true,
+					},
+					"default": schema.BoolAttribute{
+						Description: "Whether the record type is the default of the configured user.",
+						Computed:    true,
+					},
+					"master": schema.BoolAttribute{
+						Description: "Whether the record type is the master record type.",
+						Computed:    true,
 					},
 				},
 			},
 		},
+		"record_type_ids": schema.MapAttribute{
+			ElementType: types.StringType,
+			Description: "IDs of the record types of the described object by their API name.",
+			Computed:    true,
+		},
 	}
 }
 
 // descriptionDataSourceModel maps the data source schema data.
 type descriptionDataSourceModel struct {
-	ID     types.String            `tfsdk:"id"`
-	Name   types.String            `tfsdk:"name"`
-	Label  types.String            `tfsdk:"label"`
-	Fields []descriptionFieldModel `tfsdk:"fields"`
+	ID                 types.String                     `tfsdk:"id"`
+	Name               types.String                     `tfsdk:"name"`
+	Label              types.String                     `tfsdk:"label"`
+	LabelPlural        types.String                     `tfsdk:"label_plural"`
+	KeyPrefix          types.String                     `tfsdk:"key_prefix"`
+	Custom             types.Bool                       `tfsdk:"custom"`
+	CustomSetting      types.Bool                       `tfsdk:"custom_setting"`
+	Createable         types.Bool                       `tfsdk:"createable"`
+	Updateable         types.Bool                       `tfsdk:"updateable"`
+	Deletable          types.Bool                       `tfsdk:"deletable"`
+	Undeletable        types.Bool                       `tfsdk:"undeletable"`
+	Queryable          types.Bool                       `tfsdk:"queryable"`
+	Retrieveable       types.Bool                       `tfsdk:"retrieveable"`
+	Searchable         types.Bool                       `tfsdk:"searchable"`
+	Mergeable          types.Bool                       `tfsdk:"mergeable"`
+	Triggerable        types.Bool                       `tfsdk:"triggerable"`
+	FeedEnabled        types.Bool                       `tfsdk:"feed_enabled"`
+	FieldNames         []types.String                   `tfsdk:"field_names"`
+	Fields             []descriptionFieldModel          `tfsdk:"fields"`
+	FieldsByName       map[string]descriptionFieldModel `tfsdk:"fields_by_name"`
+	ChildRelationships []childRelationshipModel         `tfsdk:"child_relationships"`
+	RecordTypes        []recordTypeModel                `tfsdk:"record_types"`
+	RecordTypeIDs      map[string]string                `tfsdk:"record_type_ids"`
+}
+
+type childRelationshipModel struct {
+	ChildSObject        types.String `tfsdk:"child_sobject"`
+	Field               types.String `tfsdk:"field"`
+	RelationshipName    types.String `tfsdk:"relationship_name"`
+	CascadeDelete       types.Bool   `tfsdk:"cascade_delete"`
+	RestrictedDelete    types.Bool   `tfsdk:"restricted_delete"`
+	DeprecatedAndHidden types.Bool   `tfsdk:"deprecated_and_hidden"`
+}
+
+type recordTypeModel struct {
+	Name          types.String `tfsdk:"name"`
+	DeveloperName types.String `tfsdk:"developer_name"`
+	RecordTypeID  types.String `tfsdk:"record_type_id"`
+	Active        types.Bool   `tfsdk:"active"`
+	Available     types.Bool   `tfsdk:"available"`
+	Default       types.Bool   `tfsdk:"default"`
+	Master        types.Bool   `tfsdk:"master"`
 }
 
 type descriptionFieldModel struct {
-	Name  types.String `tfsdk:"name"`
-	Label types.String `tfsdk:"label"`
-	Type  types.String `tfsdk:"type"`
+	Name                         types.String         `tfsdk:"name"`
+	Label                        types.String         `tfsdk:"label"`
+	Type                         types.String         `tfsdk:"type"`
+	Length                       types.Int64          `tfsdk:"length"`
+	ByteLength                   types.Int64          `tfsdk:"byte_length"`
+	Precision                    types.Int64          `tfsdk:"precision"`
+	Scale                        types.Int64          `tfsdk:"scale"`
+	Digits                       types.Int64          `tfsdk:"digits"`
+	SoapType                     types.String         `tfsdk:"soap_type"`
+	Nillable                     types.Bool           `tfsdk:"nillable"`
+	Unique                       types.Bool           `tfsdk:"unique"`
+	CaseSensitive                types.Bool           `tfsdk:"case_sensitive"`
+	ExternalID                   types.Bool           `tfsdk:"external_id"`
+	IDLookup                     types.Bool           `tfsdk:"id_lookup"`
+	NameField                    types.Bool           `tfsdk:"name_field"`
+	Custom                       types.Bool           `tfsdk:"custom"`
+	Createable                   types.Bool           `tfsdk:"createable"`
+	Updateable                   types.Bool           `tfsdk:"updateable"`
+	DefaultedOnCreate            types.Bool           `tfsdk:"defaulted_on_create"`
+	Filterable                   types.Bool           `tfsdk:"filterable"`
+	Sortable                     types.Bool           `tfsdk:"sortable"`
+	Groupable                    types.Bool           `tfsdk:"groupable"`
+	Aggregatable                 types.Bool           `tfsdk:"aggregatable"`
+	Permissionable               types.Bool           `tfsdk:"permissionable"`
+	Encrypted                    types.Bool           `tfsdk:"encrypted"`
+	HTMLFormatted                types.Bool           `tfsdk:"html_formatted"`
+	AutoNumber                   types.Bool           `tfsdk:"auto_number"`
+	Calculated                   types.Bool           `tfsdk:"calculated"`
+	CalculatedFormula            types.String         `tfsdk:"calculated_formula"`
+	FormulaTreatNullNumberAsZero types.Bool           `tfsdk:"formula_treat_null_number_as_zero"`
+	HighScaleNumber              types.Bool           `tfsdk:"high_scale_number"`
+	DefaultValue                 types.String         `tfsdk:"default_value"`
+	DefaultValueFormula          types.String         `tfsdk:"default_value_formula"`
+	InlineHelpText               types.String         `tfsdk:"inline_help_text"`
+	ReferenceTo                  []types.String       `tfsdk:"reference_to"`
+	RelationshipName             types.String         `tfsdk:"relationship_name"`
+	RelationshipOrder            types.Int64          `tfsdk:"relationship_order"`
+	ReferenceTargetField         types.String         `tfsdk:"reference_target_field"`
+	PolymorphicForeignKey        types.Bool           `tfsdk:"polymorphic_foreign_key"`
+	CascadeDelete                types.Bool           `tfsdk:"cascade_delete"`
+	RestrictedDelete             types.Bool           `tfsdk:"restricted_delete"`
+	WriteRequiresMasterRead      types.Bool           `tfsdk:"write_requires_master_read"`
+	CompoundFieldName            types.String         `tfsdk:"compound_field_name"`
+	ExtraTypeInfo                types.String         `tfsdk:"extra_type_info"`
+	Mask                         types.String         `tfsdk:"mask"`
+	MaskType                     types.String         `tfsdk:"mask_type"`
+	DeprecatedAndHidden          types.Bool           `tfsdk:"deprecated_and_hidden"`
+	PicklistValues               []picklistValueModel `tfsdk:"picklist_values"`
+	ControllerName               types.String         `tfsdk:"controller_name"`
+	DependentPicklist            types.Bool           `tfsdk:"dependent_picklist"`
+	RestrictedPicklist           types.Bool           `tfsdk:"restricted_picklist"`
+	DependentValues              map[string][]string  `tfsdk:"dependent_values"`
+}
+
+type picklistValueModel struct {
+	Value        types.String   `tfsdk:"value"`
+	Label        types.String   `tfsdk:"label"`
+	Active       types.Bool     `tfsdk:"active"`
+	DefaultValue types.Bool     `tfsdk:"default_value"`
+	ValidFor     []types.String `tfsdk:"valid_for"`
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -124,8 +356,18 @@ func (d *descriptionDataSource) Read(ctx context.Context, req datasource.ReadReq
 	})
 
 	description, err := d.client.GetDescription(
+		ctx,
 		state.Name.ValueString(),
 	)
+	if salesforce.IsNotFound(err) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Salesforce Object Not Found",
+			fmt.Sprintf("The Salesforce object %q does not exist or is not accessible to the configured user. "+
+				"Check the API name of the object, including the __c suffix of custom objects.\n\n%s", state.Name.ValueString(), err.Error()),
+		)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Salesforce descriptions",
@@ -134,25 +376,435 @@ func (d *descriptionDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
-	// Map response body to model
-	for _, field := range description.Fields {
-		col := descriptionFieldModel{
-			Name:  types.StringValue(field.Name),
-			Label: types.StringValue(field.Label),
-			Type:  types.StringValue(field.Type),
-		}
-
-		state.Fields = append(state.Fields, col)
+	state, diags := newDescriptionModel(description, state.FieldNames, path.Root("field_names"))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	state.ID = types.StringValue("placeholder")
-	state.Name = types.StringValue(description.Name)
-	state.Label = types.StringValue(description.Label)
-
 	// Set state
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
+
+// newDescriptionModel maps description to the model of a described object,
+// limited to the fields named in fieldNames unless it is nil. Unknown field
+// names are reported at fieldNamesPath.
+func newDescriptionModel(description *salesforce.Description, fieldNames []types.String, fieldNamesPath path.Path) (descriptionDataSourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	model := descriptionDataSourceModel{FieldNames: fieldNames}
+
+	// Limit the fields to the requested ones
+	fields := description.Fields
+	if fieldNames != nil {
+		fields = nil
+		for i, name := range fieldNames {
+			field, ok := description.Field(name.ValueString())
+			if !ok {
+				diags.AddAttributeError(
+					fieldNamesPath.AtListIndex(i),
+					"Salesforce Field Not Found",
+					fmt.Sprintf("The Salesforce object %q has no field %q.", description.Name, name.ValueString()),
+				)
+				continue
+			}
+			fields = append(fields, *field)
+		}
+		if diags.HasError() {
+			return model, diags
+		}
+	}
+
+	model.FieldsByName = map[string]descriptionFieldModel{}
+	for _, field := range fields {
+		col, err := newDescriptionFieldModel(description, field)
+		if err != nil {
+			diags.AddError(
+				"Unable to Read Salesforce descriptions",
+				err.Error(),
+			)
+			return model, diags
+		}
+
+		model.Fields = append(model.Fields, col)
+		model.FieldsByName[field.Name] = col
+	}
+
+	model.ID = types.StringValue(description.Name)
+	model.Name = types.StringValue(description.Name)
+	model.Label = types.StringValue(description.Label)
+	model.LabelPlural = types.StringValue(description.LabelPlural)
+	model.KeyPrefix = types.StringPointerValue(description.KeyPrefix)
+	model.Custom = types.BoolValue(description.Custom)
+	model.CustomSetting = types.BoolValue(description.CustomSetting)
+	model.Createable = types.BoolValue(description.Createable)
+	model.Updateable = types.BoolValue(description.Updateable)
+	model.Deletable = types.BoolValue(description.Deletable)
+	model.Undeletable = types.BoolValue(description.Undeletable)
+	model.Queryable = types.BoolValue(description.Queryable)
+	model.Retrieveable = types.BoolValue(description.Retrieveable)
+	model.Searchable = types.BoolValue(description.Searchable)
+	model.Mergeable = types.BoolValue(description.Mergeable)
+	model.Triggerable = types.BoolValue(description.Triggerable)
+	model.FeedEnabled = types.BoolValue(description.FeedEnabled)
+
+	model.ChildRelationships = []childRelationshipModel{}
+	for _, relationship := range description.ChildRelationships {
+		model.ChildRelationships = append(model.ChildRelationships, childRelationshipModel{
+			ChildSObject:        types.StringValue(relationship.ChildSObject),
+			Field:               types.StringValue(relationship.Field),
+			RelationshipName:    types.StringPointerValue(relationship.RelationshipName),
+			CascadeDelete:       types.BoolValue(relationship.CascadeDelete),
+			RestrictedDelete:    types.BoolValue(relationship.RestrictedDelete),
+			DeprecatedAndHidden: types.BoolValue(relationship.DeprecatedAndHidden),
+		})
+	}
+
+	model.RecordTypes = []recordTypeModel{}
+	model.RecordTypeIDs = map[string]string{}
+	for _, recordType := range description.RecordTypeInfos {
+		model.RecordTypes = append(model.RecordTypes, recordTypeModel{
+			Name:          types.StringValue(recordType.Name),
+			DeveloperName: types.StringValue(recordType.DeveloperName),
+			RecordTypeID:  types.StringValue(recordType.RecordTypeID),
+			Active:        types.BoolValue(recordType.Active),
+			Available:     types.BoolValue(recordType.Available),
+			Default:       types.BoolValue(recordType.DefaultRecordTypeMapping),
+			Master:        types.BoolValue(recordType.Master),
+		})
+		model.RecordTypeIDs[recordType.DeveloperName] = recordType.RecordTypeID
+	}
+
+	return model, diags
+}
+
+// descriptionFieldAttributes returns the schema of a described field.
+func descriptionFieldAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			Description: "Name of the field.",
+			Computed:    true,
+		},
+		"label": schema.StringAttribute{
+			Description: "Label of the field.",
+			Computed:    true,
+		},
+		"type": schema.StringAttribute{
+			Description: "Type of the field.",
+			Computed:    true,
+		},
+		"length": schema.Int64Attribute{
+			Description: "Maximum number of characters of text fields.",
+			Computed:    true,
+		},
+		"byte_length": schema.Int64Attribute{
+			Description: "Maximum size of the field in bytes.",
+			Computed:    true,
+		},
+		"precision": schema.Int64Attribute{
+			Description: "Total number of digits of number fields.",
+			Computed:    true,
+		},
+		"scale": schema.Int64Attribute{
+			Description: "Number of digits right of the decimal point of number fields.",
+			Computed:    true,
+		},
+		"digits": schema.Int64Attribute{
+			Description: "Maximum number of digits of integer fields.",
+			Computed:    true,
+		},
+		"soap_type": schema.StringAttribute{
+			Description: "SOAP type of the field, e.g. `xsd:string`.",
+			Computed:    true,
+		},
+		"nillable": schema.BoolAttribute{
+			Description: "Whether the field can be empty.",
+			Computed:    true,
+		},
+		"unique": schema.BoolAttribute{
+			Description: "Whether values of the field must be unique.",
+			Computed:    true,
+		},
+		"case_sensitive": schema.BoolAttribute{
+			Description: "Whether the field is case sensitive.",
+			Computed:    true,
+		},
+		"external_id": schema.BoolAttribute{
+			Description: "Whether the field is an external ID.",
+			Computed:    true,
+		},
+		"id_lookup": schema.BoolAttribute{
+			Description: "Whether the field can identify records in upserts.",
+			Computed:    true,
+		},
+		"name_field": schema.BoolAttribute{
+			Description: "Whether the field is the name field of the object.",
+			Computed:    true,
+		},
+		"custom": schema.BoolAttribute{
+			Description: "Whether the field is a custom field.",
+			Computed:    true,
+		},
+		"createable": schema.BoolAttribute{
+			Description: "Whether the field can be set on create.",
+			Computed:    true,
+		},
+		"updateable": schema.BoolAttribute{
+			Description: "Whether the field can be updated.",
+			Computed:    true,
+		},
+		"defaulted_on_create": schema.BoolAttribute{
+			Description: "Whether Salesforce sets a default value on create.",
+			Computed:    true,
+		},
+		"filterable": schema.BoolAttribute{
+			Description: "Whether the field can be used in query filters.",
+			Computed:    true,
+		},
+		"sortable": schema.BoolAttribute{
+			Description: "Whether query results can be sorted by the field.",
+			Computed:    true,
+		},
+		"groupable": schema.BoolAttribute{
+			Description: "Whether query results can be grouped by the field.",
+			Computed:    true,
+		},
+		"aggregatable": schema.BoolAttribute{
+			Description: "Whether the field can be aggregated in queries.",
+			Computed:    true,
+		},
+		"permissionable": schema.BoolAttribute{
+			Description: "Whether field-level security can be set for the field.",
+			Computed:    true,
+		},
+		"encrypted": schema.BoolAttribute{
+			Description: "Whether the field is encrypted.",
+			Computed:    true,
+		},
+		"html_formatted": schema.BoolAttribute{
+			Description: "Whether the field contains HTML.",
+			Computed:    true,
+		},
+		"auto_number": schema.BoolAttribute{
+			Description: "Whether the field is an auto number.",
+			Computed:    true,
+		},
+		"calculated": schema.BoolAttribute{
+			Description: "Whether the field is a formula or roll-up summary field.",
+			Computed:    true,
+		},
+		"calculated_formula": schema.StringAttribute{
+			Description: "Formula of formula fields.",
+			Computed:    true,
+		},
+		"formula_treat_null_number_as_zero": schema.BoolAttribute{
+			Description: "Whether the formula treats empty number fields as zero.",
+			Computed:    true,
+		},
+		"high_scale_number": schema.BoolAttribute{
+			Description: "Whether the field stores numbers with up to eight decimal places.",
+			Computed:    true,
+		},
+		"default_value": schema.StringAttribute{
+			Description: "Default value of the field, booleans and numbers formatted as string.",
+			Computed:    true,
+		},
+		"default_value_formula": schema.StringAttribute{
+			Description: "Formula that computes the default value of the field.",
+			Computed:    true,
+		},
+		"inline_help_text": schema.StringAttribute{
+			Description: "Help text of the field.",
+			Computed:    true,
+		},
+		"reference_to": schema.ListAttribute{
+			ElementType: types.StringType,
+			Description: "Objects the field refers to, for lookup and master-detail fields.",
+			Computed:    true,
+		},
+		"relationship_name": schema.StringAttribute{
+			Description: "Name of the relationship of lookup and master-detail fields.",
+			Computed:    true,
+		},
+		"relationship_order": schema.Int64Attribute{
+			Description: "Whether the field is the primary (0) or secondary (1) master-detail relationship of a junction object.",
+			Computed:    true,
+		},
+		"reference_target_field": schema.StringAttribute{
+			Description: "Field of the referenced object an indirect lookup matches on.",
+			Computed:    true,
+		},
+		"polymorphic_foreign_key": schema.BoolAttribute{
+			Description: "Whether the field can refer to more than one object.",
+			Computed:    true,
+		},
+		"cascade_delete": schema.BoolAttribute{
+			Description: "Whether deleting the referenced record deletes the record.",
+			Computed:    true,
+		},
+		"restricted_delete": schema.BoolAttribute{
+			Description: "Whether the referenced record cannot be deleted while records refer to it.",
+			Computed:    true,
+		},
+		"write_requires_master_read": schema.BoolAttribute{
+			Description: "Whether writing the record only requires read access to the master record.",
+			Computed:    true,
+		},
+		"compound_field_name": schema.StringAttribute{
+			Description: "Name of the compound field the field is part of, e.g. `BillingAddress`.",
+			Computed:    true,
+		},
+		"extra_type_info": schema.StringAttribute{
+			Description: "Additional type information, e.g. `richtextarea` or `personname`.",
+			Computed:    true,
+		},
+		"mask": schema.StringAttribute{
+			Description: "Mask character of encrypted fields.",
+			Computed:    true,
+		},
+		"mask_type": schema.StringAttribute{
+			Description: "Mask type of encrypted fields.",
+			Computed:    true,
+		},
+		"deprecated_and_hidden": schema.BoolAttribute{
+			Description: "Whether the field is deprecated.",
+			Computed:    true,
+		},
+		"picklist_values": schema.ListNestedAttribute{
+			Description: "Values of picklist fields.",
+			Computed:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"value": schema.StringAttribute{
+						Description: "API name of the value.",
+						Computed:    true,
+					},
+					"label": schema.StringAttribute{
+						Description: "Label of the value.",
+						Computed:    true,
+					},
+					"active": schema.BoolAttribute{
+						Description: "Whether the value is active.",
+						Computed:    true,
+					},
+					"default_value": schema.BoolAttribute{
+						Description: "Whether the value is the default of the field.",
+						Computed:    true,
+					},
+					"valid_for": schema.ListAttribute{
+						ElementType: types.StringType,
+						Description: "Values of the controlling field the value is valid for, for dependent picklists.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+		"controller_name": schema.StringAttribute{
+			Description: "Name of the field controlling a dependent picklist.",
+			Computed:    true,
+		},
+		"dependent_picklist": schema.BoolAttribute{
+			Description: "Whether the field is a dependent picklist.",
+			Computed:    true,
+		},
+		"restricted_picklist": schema.BoolAttribute{
+			Description: "Whether the field only accepts its picklist values.",
+			Computed:    true,
+		},
+		"dependent_values": schema.MapAttribute{
+			ElementType: types.ListType{ElemType: types.StringType},
+			Description: "Values of a dependent picklist valid for each value of the controlling field.",
+			Computed:    true,
+		},
+	}
+}
+
+// newDescriptionFieldModel maps a field of description to its model.
+func newDescriptionFieldModel(description *salesforce.Description, field salesforce.DescriptionField) (descriptionFieldModel, error) {
+	model := descriptionFieldModel{
+		Name:                         types.StringValue(field.Name),
+		Label:                        types.StringValue(field.Label),
+		Type:                         types.StringValue(field.Type),
+		Length:                       types.Int64Value(int64(field.Length)),
+		ByteLength:                   types.Int64Value(int64(field.ByteLength)),
+		Precision:                    types.Int64Value(int64(field.Precision)),
+		Scale:                        types.Int64Value(int64(field.Scale)),
+		Digits:                       types.Int64Value(int64(field.Digits)),
+		SoapType:                     types.StringValue(field.SoapType),
+		Nillable:                     types.BoolValue(field.Nillable),
+		Unique:                       types.BoolValue(field.Unique),
+		CaseSensitive:                types.BoolValue(field.CaseSensitive),
+		ExternalID:                   types.BoolValue(field.ExternalID),
+		IDLookup:                     types.BoolValue(field.IDLookup),
+		NameField:                    types.BoolValue(field.NameField),
+		Custom:                       types.BoolValue(field.Custom),
+		Createable:                   types.BoolValue(field.Createable),
+		Updateable:                   types.BoolValue(field.Updateable),
+		DefaultedOnCreate:            types.BoolValue(field.DefaultedOnCreate),
+		Filterable:                   types.BoolValue(field.Filterable),
+		Sortable:                     types.BoolValue(field.Sortable),
+		Groupable:                    types.BoolValue(field.Groupable),
+		Aggregatable:                 types.BoolValue(field.Aggregatable),
+		Permissionable:               types.BoolValue(field.Permissionable),
+		Encrypted:                    types.BoolValue(field.Encrypted),
+		HTMLFormatted:                types.BoolValue(field.HTMLFormatted),
+		AutoNumber:                   types.BoolValue(field.AutoNumber),
+		Calculated:                   types.BoolValue(field.Calculated),
+		CalculatedFormula:            types.StringPointerValue(field.CalculatedFormula),
+		FormulaTreatNullNumberAsZero: types.BoolValue(field.FormulaTreatNullNumberAsZero),
+		HighScaleNumber:              types.BoolValue(field.HighScaleNumber),
+		DefaultValue:                 jsonValueString(field.DefaultValue),
+		DefaultValueFormula:          types.StringPointerValue(field.DefaultValueFormula),
+		InlineHelpText:               types.StringPointerValue(field.InlineHelpText),
+		ReferenceTo:                  stringValues(field.ReferenceTo),
+		RelationshipName:             types.StringPointerValue(field.RelationshipName),
+		RelationshipOrder:            int64PointerValue(field.RelationshipOrder),
+		ReferenceTargetField:         types.StringPointerValue(field.ReferenceTargetField),
+		PolymorphicForeignKey:        types.BoolValue(field.PolymorphicForeignKey),
+		CascadeDelete:                types.BoolValue(field.CascadeDelete),
+		RestrictedDelete:             types.BoolValue(field.RestrictedDelete),
+		WriteRequiresMasterRead:      types.BoolValue(field.WriteRequiresMasterRead),
+		CompoundFieldName:            types.StringPointerValue(field.CompoundFieldName),
+		ExtraTypeInfo:                types.StringPointerValue(field.ExtraTypeInfo),
+		Mask:                         types.StringPointerValue(field.Mask),
+		MaskType:                     types.StringPointerValue(field.MaskType),
+		DeprecatedAndHidden:          types.BoolValue(field.DeprecatedAndHidden),
+		PicklistValues:               []picklistValueModel{},
+		ControllerName:               types.StringPointerValue(field.ControllerName),
+		DependentPicklist:            types.BoolValue(field.DependentPicklist),
+		RestrictedPicklist:           types.BoolValue(field.RestrictedPicklist),
+	}
+
+	// Decode the validFor bitmaps of dependent picklists.
+	var controllingValues []string
+	if field.DependentPicklist && field.ControllerName != nil {
+		var err error
+		controllingValues, err = description.ControllingValues(field)
+		if err != nil {
+			return model, err
+		}
+		model.DependentValues, err = description.DependentValues(field)
+		if err != nil {
+			return model, err
+		}
+	}
+
+	for _, value := range field.PicklistValues {
+		validFor, err := value.ValidForValues(controllingValues)
+		if err != nil {
+			return model, err
+		}
+		model.PicklistValues = append(model.PicklistValues, picklistValueModel{
+			Value:        types.StringValue(value.Value),
+			Label:        types.StringPointerValue(value.Label),
+			Active:       types.BoolValue(value.Active),
+			DefaultValue: types.BoolValue(value.DefaultValue),
+			ValidFor:     stringValues(validFor),
+		})
+	}
+
+	return model, nil
+}
@@ -1,36 +1,96 @@
 package provider
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
 func TestAccDescriptionsDataSource(t *testing.T) {
+	org := newMockSalesforce(t)
+
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			// Read testing
 			{
-				Config: providerConfig + `data "salesforce_description" "test" {
+				Config: org.providerConfig() + `data "salesforce_description" "test" {
 					name = "test"
 				}`,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					// Verify metadata
 					resource.TestCheckResourceAttr("data.salesforce_description.test", "name", "test"),
 					resource.TestCheckResourceAttr("data.salesforce_description.test", "label", "Training Course"),
+					resource.TestCheckResourceAttr("data.salesforce_description.test", "label_plural", "Training Courses"),
+					resource.TestCheckResourceAttr("data.salesforce_description.test", "key_prefix", "a4K"),
+					resource.TestCheckResourceAttr("data.salesforce_description.test", "custom", "true"),
+					resource.TestCheckResourceAttr("data.salesforce_description.test", "queryable", "true"),
+
+					// Verify child relationships and record types
+					resource.TestCheckResourceAttr("data.salesforce_description.test", "child_relationships.#", "2"),
+					resource.TestCheckResourceAttr("data.salesforce_description.test", "child_relationships.0.child_sobject", "AIInsightValue"),
+					resource.TestCheckResourceAttr("data.salesforce_description.test", "child_relationships.0.field", "SobjectLookupValueId"),
+					resource.TestCheckResourceAttr("data.salesforce_description.test", "child_relationships.0.cascade_delete", "true"),
+					resource.TestCheckResourceAttr("data.salesforce_description.test", "record_types.#", "3"),
+					resource.TestCheckResourceAttr("data.salesforce_description.test", "record_types.0.developer_name", "VuB_Product_Training"),
+					resource.TestCheckResourceAttr("data.salesforce_description.test", "record_types.0.default", "true"),
+					resource.TestCheckResourceAttr("data.salesforce_description.test", "record_type_ids.VuB_Product_Training", "01269000000ug7EAAQ"),
+					resource.TestCheckResourceAttr("data.salesforce_description.test", "record_type_ids.Master", "012000000000000AAA"),
+
+					// Verify number of fields returned
+					resource.TestCheckResourceAttr("data.salesforce_description.test", "fields.#", "2"),
+					// Verify the first field to ensure all attributes are set
+					resource.TestCheckResourceAttr("data.salesforce_description.test", "fields.0.name", "OwnerId"),
+					resource.TestCheckResourceAttr("data.salesforce_description.test", "fields.0.label", "Owner ID"),
+					resource.TestCheckResourceAttr("data.salesforce_description.test", "fields.0.type", "reference"),
+					resource.TestCheckResourceAttr("data.salesforce_description.test", "fields.0.length", "18"),
+					resource.TestCheckResourceAttr("data.salesforce_description.test", "fields.0.soap_type", "tns:ID"),
+					resource.TestCheckResourceAttr("data.salesforce_description.test", "fields.0.nillable", "false"),
+					resource.TestCheckResourceAttr("data.salesforce_description.test", "fields.0.createable", "true"),
+					resource.TestCheckResourceAttr("data.salesforce_description.test", "fields.0.relationship_name", "Owner"),
+					resource.TestCheckResourceAttr("data.salesforce_description.test", "fields.0.reference_to.#", "2"),
+					resource.TestCheckResourceAttr("data.salesforce_description.test", "fields.0.reference_to.0", "Group"),
+					resource.TestCheckNoResourceAttr("data.salesforce_description.test", "fields.0.default_value"),
+					// Verify the second field
+					resource.TestCheckResourceAttr("data.salesforce_description.test", "fields.1.precision", "6"),
+					resource.TestCheckResourceAttr("data.salesforce_description.test", "fields.1.custom", "true"),
+					resource.TestCheckResourceAttr("data.salesforce_description.test", "fields.1.restricted_picklist", "true"),
+					resource.TestCheckResourceAttr("data.salesforce_description.test", "fields.1.picklist_values.#", "6"),
+					resource.TestCheckResourceAttr("data.salesforce_description.test", "fields.1.picklist_values.0.value", "ViClean"),
+					resource.TestCheckResourceAttr("data.salesforce_description.test", "fields.1.picklist_values.0.active", "true"),
+					resource.TestCheckResourceAttr("data.salesforce_description.test", "fields.1.picklist_values.0.valid_for.#", "0"),
 
-					// Verify number of columns returned
-					resource.TestCheckResourceAttr("data.salesforce_description.test", "columns.#", "2"),
-					// Verify the first column to ensure all attributes are set
-					resource.TestCheckResourceAttr("data.salesforce_description.test", "columns.0.name", "OwnerId"),
-					resource.TestCheckResourceAttr("data.salesforce_description.test", "columns.0.label", "Owner ID"),
-					resource.TestCheckResourceAttr("data.salesforce_description.test", "columns.0.type", "reference"),
+					// Verify fields by name
+					resource.TestCheckResourceAttr("data.salesforce_description.test", "fields_by_name.%", "2"),
+					resource.TestCheckResourceAttr("data.salesforce_description.test", "fields_by_name.OwnerId.type", "reference"),
+					resource.TestCheckResourceAttr("data.salesforce_description.test", "fields_by_name.OwnerId.label", "Owner ID"),
 
-					// Verify placeholder id attribute
-					resource.TestCheckResourceAttr("data.salesforce_description.test", "id", "placeholder"),
+					// Verify id attribute
+					resource.TestCheckResourceAttr("data.salesforce_description.test", "id", "test"),
 				),
 			},
+			// Read a subset of the fields
+			{
+				Config: org.providerConfig() + `data "salesforce_description" "test" {
+					name        = "test"
+					field_names = ["ownerid"]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.salesforce_description.test", "fields.#", "1"),
+					resource.TestCheckResourceAttr("data.salesforce_description.test", "fields.0.name", "OwnerId"),
+					resource.TestCheckResourceAttr("data.salesforce_description.test", "fields_by_name.%", "1"),
+					resource.TestCheckResourceAttr("data.salesforce_description.test", "fields_by_name.OwnerId.type", "reference"),
+				),
+			},
+			// Unknown field names are reported
+			{
+				Config: org.providerConfig() + `data "salesforce_description" "test" {
+					name        = "test"
+					field_names = ["NoSuchField__c"]
+				}`,
+				ExpectError: regexp.MustCompile("Salesforce Field Not Found"),
+			},
 		},
 	})
 }
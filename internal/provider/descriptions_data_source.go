@@ -0,0 +1,184 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/villeroy-boch/terraform-provider-salesforce/internal/salesforce"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &descriptionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &descriptionsDataSource{}
+)
+
+// NewDescriptionsDataSource is a helper function to simplify the provider implementation.
+func NewDescriptionsDataSource() datasource.DataSource {
+	return &descriptionsDataSource{}
+}
+
+// descriptionsDataSource is the data source implementation.
+type descriptionsDataSource struct {
+	client *salesforce.Client
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *descriptionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring Salesforce Descriptions data source")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*salesforce.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *salesforce.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+
+	tflog.Info(ctx, "Configured Salesforce Descriptions data source", map[string]any{"success": true})
+}
+
+// Metadata returns the data source type name.
+func (d *descriptionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_descriptions"
+}
+
+// Schema defines the schema for the data source.
+func (d *descriptionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	descriptionAttributes := descriptionAttributes()
+	descriptionAttributes["id"] = schema.StringAttribute{
+		Description: "API name of the described object.",
+		Computed:    true,
+	}
+	descriptionAttributes["name"] = schema.StringAttribute{
+		Description: "Name of the described object.",
+		Computed:    true,
+	}
+	descriptionAttributes["field_names"] = schema.ListAttribute{
+		ElementType: types.StringType,
+		Description: "API names the fields of the object are limited to.",
+		Computed:    true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Fetches the descriptions of several objects through the Composite/Batch API.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the descriptions, the comma separated names of the described objects.",
+				Computed:    true,
+			},
+			"names": schema.ListAttribute{
+				ElementType: types.StringType,
+				Description: "Names of the described objects.",
+				Required:    true,
+			},
+			"field_names": schema.MapAttribute{
+				ElementType: types.ListType{ElemType: types.StringType},
+				Description: "API names of the fields to return by object name, matched case-insensitively. Objects without an entry return all fields.",
+				Optional:    true,
+			},
+			"descriptions": schema.ListNestedAttribute{
+				Description: "Descriptions of the objects in the order of `names`, each like the `salesforce_description` data source.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: descriptionAttributes,
+				},
+			},
+		},
+	}
+}
+
+// descriptionsDataSourceModel maps the data source schema data.
+type descriptionsDataSourceModel struct {
+	ID           types.String                 `tfsdk:"id"`
+	Names        []string                     `tfsdk:"names"`
+	FieldNames   map[string][]types.String    `tfsdk:"field_names"`
+	Descriptions []descriptionDataSourceModel `tfsdk:"descriptions"`
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *descriptionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state descriptionsDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Reading Salesforce Descriptions data source", map[string]any{
+		"input": fmt.Sprintf("%+v", state),
+	})
+
+	names := map[string]bool{}
+	for _, name := range state.Names {
+		names[name] = true
+	}
+	for name := range state.FieldNames {
+		if !names[name] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("field_names").AtMapKey(name),
+				"Unknown Object Name",
+				fmt.Sprintf("Field names are given for %q, which is not one of the described objects.", name),
+			)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	descriptions, err := d.client.GetDescriptions(ctx, state.Names)
+	if salesforce.IsNotFound(err) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("names"),
+			"Salesforce Object Not Found",
+			"A Salesforce object does not exist or is not accessible to the configured user. "+
+				"Check the API names of the objects, including the __c suffix of custom objects.\n\n"+err.Error(),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Salesforce descriptions",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response bodies to model
+	state.Descriptions = []descriptionDataSourceModel{}
+	for i, description := range descriptions {
+		name := state.Names[i]
+		model, diags := newDescriptionModel(description, state.FieldNames[name], path.Root("field_names").AtMapKey(name))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		state.Descriptions = append(state.Descriptions, model)
+	}
+
+	state.ID = types.StringValue(strings.Join(state.Names, ","))
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDescriptionsBatchDataSource(t *testing.T) {
+	org := newMockSalesforce(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: org.providerConfig() + `data "salesforce_descriptions" "test" {
+					names = ["test"]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.salesforce_descriptions.test", "id", "test"),
+					resource.TestCheckResourceAttr("data.salesforce_descriptions.test", "descriptions.#", "1"),
+					resource.TestCheckResourceAttr("data.salesforce_descriptions.test", "descriptions.0.id", "test"),
+					resource.TestCheckResourceAttr("data.salesforce_descriptions.test", "descriptions.0.name", "test"),
+					resource.TestCheckResourceAttr("data.salesforce_descriptions.test", "descriptions.0.label", "Training Course"),
+					resource.TestCheckResourceAttr("data.salesforce_descriptions.test", "descriptions.0.key_prefix", "a4K"),
+					resource.TestCheckResourceAttr("data.salesforce_descriptions.test", "descriptions.0.fields.#", "2"),
+					resource.TestCheckResourceAttr("data.salesforce_descriptions.test", "descriptions.0.fields_by_name.OwnerId.type", "reference"),
+					resource.TestCheckResourceAttr("data.salesforce_descriptions.test", "descriptions.0.record_type_ids.Master", "012000000000000AAA"),
+				),
+			},
+			// Read a subset of the fields
+			{
+				Config: org.providerConfig() + `data "salesforce_descriptions" "test" {
+					names       = ["test"]
+					field_names = {
+						test = ["OwnerId"]
+					}
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.salesforce_descriptions.test", "descriptions.0.fields.#", "1"),
+					resource.TestCheckResourceAttr("data.salesforce_descriptions.test", "descriptions.0.fields.0.name", "OwnerId"),
+					resource.TestCheckResourceAttr("data.salesforce_descriptions.test", "descriptions.0.field_names.#", "1"),
+				),
+			},
+		},
+	})
+}
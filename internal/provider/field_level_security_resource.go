@@ -0,0 +1,490 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/villeroy-boch/terraform-provider-salesforce/internal/salesforce"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &fieldLevelSecurityResource{}
+	_ resource.ResourceWithConfigure      = &fieldLevelSecurityResource{}
+	_ resource.ResourceWithImportState    = &fieldLevelSecurityResource{}
+	_ resource.ResourceWithModifyPlan     = &fieldLevelSecurityResource{}
+	_ resource.ResourceWithValidateConfig = &fieldLevelSecurityResource{}
+)
+
+// NewFieldLevelSecurityResource is a helper function to simplify the provider implementation.
+func NewFieldLevelSecurityResource() resource.Resource {
+	return &fieldLevelSecurityResource{}
+}
+
+// fieldLevelSecurityResource is the resource implementation.
+type fieldLevelSecurityResource struct {
+	client *salesforce.Client
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *fieldLevelSecurityResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring Salesforce Field-Level Security resource")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*salesforce.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *salesforce.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+
+	tflog.Info(ctx, "Configured Salesforce Field-Level Security resource", map[string]any{"success": true})
+}
+
+// Metadata returns the resource type name.
+func (r *fieldLevelSecurityResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_field_level_security"
+}
+
+// Schema defines the schema for the resource.
+func (r *fieldLevelSecurityResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+
+	accessAttribute := func(description string) schema.MapNestedAttribute {
+		return schema.MapNestedAttribute{
+			Description: description,
+			Optional:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"read": schema.BoolAttribute{
+						Description: "Whether the field can be read.",
+						Required:    true,
+					},
+					"edit": schema.BoolAttribute{
+						Description: "Whether the field can be edited, which needs read access too. Defaults to `false`.",
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(false),
+					},
+				},
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages the field-level security of one field for a set of permission sets and profiles through FieldPermissions records. " +
+			"Only the listed permission sets and profiles are managed, removing one revokes its access.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Full name of the field.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"field": schema.StringAttribute{
+				Description: "API names of the object and the field joined by a dot, e.g. `Account.vub_Region__c`. Changing it replaces the resource.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"permission_sets": accessAttribute("Access to the field by API name of the permission set."),
+			"profiles":        accessAttribute("Access to the field by name of the profile, e.g. `Standard User`."),
+		},
+	}
+}
+
+// fieldLevelSecurityResourceModel maps the resource schema data.
+type fieldLevelSecurityResourceModel struct {
+	ID             types.String                `tfsdk:"id"`
+	Field          types.String                `tfsdk:"field"`
+	PermissionSets map[string]fieldAccessModel `tfsdk:"permission_sets"`
+	Profiles       map[string]fieldAccessModel `tfsdk:"profiles"`
+}
+
+type fieldAccessModel struct {
+	Read types.Bool `tfsdk:"read"`
+	Edit types.Bool `tfsdk:"edit"`
+}
+
+// fieldLevelSecurityConfigModel maps the configuration ValidateConfig
+// checks. The access maps are kept as framework values, as they may be
+// unknown while validating.
+type fieldLevelSecurityConfigModel struct {
+	ID             types.String `tfsdk:"id"`
+	Field          types.String `tfsdk:"field"`
+	PermissionSets types.Map    `tfsdk:"permission_sets"`
+	Profiles       types.Map    `tfsdk:"profiles"`
+}
+
+// fieldAccess is the planned access of a permission set to a field.
+type fieldAccess struct {
+	permissionSet salesforce.PermissionSet
+	read          bool
+	edit          bool
+}
+
+// ValidateConfig checks the access of each permission set and profile.
+func (r *fieldLevelSecurityResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config fieldLevelSecurityConfigModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Field.IsUnknown() {
+		object, field, ok := strings.Cut(config.Field.ValueString(), ".")
+		if !ok || object == "" || field == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("field"),
+				"Invalid Field Name",
+				fmt.Sprintf("Expected a field of the form Object.Field, e.g. Account.vub_Region__c, got: %q", config.Field.ValueString()),
+			)
+		}
+	}
+
+	if config.PermissionSets.IsNull() && config.Profiles.IsNull() {
+		resp.Diagnostics.AddError(
+			"Missing Field-Level Security",
+			"Set the access of at least one permission set or profile in permission_sets or profiles.",
+		)
+	}
+
+	// Unknown maps and entries are skipped, their access is checked once
+	// it is known
+	for attribute, accesses := range map[string]types.Map{"permission_sets": config.PermissionSets, "profiles": config.Profiles} {
+		elements := accesses.Elements()
+		for _, name := range sortedKeys(elements) {
+			access, ok := elements[name].(types.Object)
+			if !ok || access.IsUnknown() {
+				continue
+			}
+			read, _ := access.Attributes()["read"].(types.Bool)
+			edit, _ := access.Attributes()["edit"].(types.Bool)
+			if edit.ValueBool() && !read.IsUnknown() && !read.ValueBool() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(attribute).AtMapKey(name).AtName("edit"),
+					"Invalid Field-Level Security",
+					"Salesforce grants edit access only together with read access, set read to true as well.",
+				)
+			}
+		}
+	}
+}
+
+// ModifyPlan checks that the field supports field-level security. Fields
+// that do not exist yet are skipped, as they may be created in the same
+// apply.
+func (r *fieldLevelSecurityResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the resource is destroyed, or before the
+	// provider is configured, e.g. with unknown provider settings
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var field types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("field"), &field)...)
+	if resp.Diagnostics.HasError() || field.IsUnknown() {
+		return
+	}
+
+	object, name, _ := strings.Cut(field.ValueString(), ".")
+	description, err := r.client.GetDescription(ctx, object)
+	if salesforce.IsNotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Salesforce descriptions",
+			err.Error(),
+		)
+		return
+	}
+
+	descriptionField, ok := description.Field(name)
+	if ok && !descriptionField.Permissionable {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("field"),
+			"Field Without Field-Level Security",
+			fmt.Sprintf("The field %q cannot have field-level security, e.g. because it is required, so it is always visible.", field.ValueString()),
+		)
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *fieldLevelSecurityResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan fieldLevelSecurityResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, plan.Field.ValueString(), plan, fieldLevelSecurityResourceModel{})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.Field
+
+	// Set state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the access the org reports.
+func (r *fieldLevelSecurityResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state fieldLevelSecurityResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permissions, err := r.client.GetFieldPermissions(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Salesforce Field-Level Security",
+			err.Error(),
+		)
+		return
+	}
+
+	permissionSets := map[string]fieldAccessModel{}
+	profiles := map[string]fieldAccessModel{}
+	for _, permission := range permissions {
+		if permission.Parent == nil {
+			continue
+		}
+		access := fieldAccessModel{
+			Read: types.BoolValue(permission.PermissionsRead),
+			Edit: types.BoolValue(permission.PermissionsEdit),
+		}
+		if profile := permission.Parent.ProfileName(); profile != "" {
+			profiles[profile] = access
+		} else {
+			permissionSets[permission.Parent.Name] = access
+		}
+	}
+
+	// Imports adopt the access of all permission sets and profiles, otherwise
+	// only the managed ones are compared
+	importing := state.PermissionSets == nil && state.Profiles == nil
+	if !importing {
+		permissionSets = managedFieldAccess(state.PermissionSets, permissionSets)
+		profiles = managedFieldAccess(state.Profiles, profiles)
+	}
+	if len(permissionSets) > 0 || state.PermissionSets != nil {
+		state.PermissionSets = permissionSets
+	}
+	if len(profiles) > 0 || state.Profiles != nil {
+		state.Profiles = profiles
+	}
+	state.Field = state.ID
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *fieldLevelSecurityResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state fieldLevelSecurityResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, plan.Field.ValueString(), plan, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.Field
+
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete revokes the access of the managed permission sets and profiles.
+func (r *fieldLevelSecurityResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state fieldLevelSecurityResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, state.ID.ValueString(), fieldLevelSecurityResourceModel{}, state)...)
+}
+
+// ImportState imports the field-level security of a field by its full name.
+func (r *fieldLevelSecurityResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// apply grants the access planned in plan and revokes the access of the
+// permission sets and profiles only found in prior.
+func (r *fieldLevelSecurityResource) apply(ctx context.Context, field string, plan, prior fieldLevelSecurityResourceModel) (diags diag.Diagnostics) {
+	permissionSetNames := sortedKeys(mergeFieldAccess(plan.PermissionSets, prior.PermissionSets))
+	profileNames := sortedKeys(mergeFieldAccess(plan.Profiles, prior.Profiles))
+
+	permissionSets, err := r.client.GetPermissionSets(ctx, permissionSetNames, profileNames)
+	if err != nil {
+		diags.AddError(
+			"Unable to Read Salesforce Permission Sets",
+			err.Error(),
+		)
+		return diags
+	}
+
+	byName := map[string]salesforce.PermissionSet{}
+	byProfile := map[string]salesforce.PermissionSet{}
+	for _, permissionSet := range permissionSets {
+		if profile := permissionSet.ProfileName(); profile != "" {
+			byProfile[strings.ToLower(profile)] = permissionSet
+		} else {
+			byName[strings.ToLower(permissionSet.Name)] = permissionSet
+		}
+	}
+
+	accesses := []fieldAccess{}
+	collect := func(attribute string, names []string, planned map[string]fieldAccessModel, found map[string]salesforce.PermissionSet) {
+		for _, name := range names {
+			permissionSet, ok := found[strings.ToLower(name)]
+			if !ok {
+				// Access of deleted permission sets is gone already
+				if _, ok := planned[name]; !ok {
+					continue
+				}
+				diags.AddAttributeError(
+					path.Root(attribute).AtMapKey(name),
+					"Salesforce Permission Set Not Found",
+					fmt.Sprintf("No permission set or profile named %q exists.", name),
+				)
+				continue
+			}
+			accesses = append(accesses, fieldAccess{
+				permissionSet: permissionSet,
+				read:          planned[name].Read.ValueBool(),
+				edit:          planned[name].Edit.ValueBool(),
+			})
+		}
+	}
+	collect("permission_sets", permissionSetNames, plan.PermissionSets, byName)
+	collect("profiles", profileNames, plan.Profiles, byProfile)
+	if diags.HasError() {
+		return diags
+	}
+
+	existing, err := r.client.GetFieldPermissions(ctx, field)
+	if err != nil {
+		diags.AddError(
+			"Unable to Read Salesforce Field-Level Security",
+			err.Error(),
+		)
+		return diags
+	}
+	byParent := map[string]salesforce.FieldPermissions{}
+	for _, permission := range existing {
+		byParent[permission.ParentID] = permission
+	}
+
+	sObjectType, _, _ := strings.Cut(field, ".")
+	for _, access := range accesses {
+		permission, exists := byParent[access.permissionSet.ID]
+
+		switch {
+		case !access.read && exists:
+			err = r.client.DeleteRecord(ctx, "FieldPermissions", permission.ID)
+			if salesforce.IsNotFound(err) {
+				err = nil
+			}
+		case !access.read:
+			continue
+		case !exists:
+			_, err = r.client.CreateRecord(ctx, "FieldPermissions", map[string]any{
+				"ParentId":        access.permissionSet.ID,
+				"SobjectType":     sObjectType,
+				"Field":           field,
+				"PermissionsRead": access.read,
+				"PermissionsEdit": access.edit,
+			})
+		case permission.PermissionsRead != access.read || permission.PermissionsEdit != access.edit:
+			err = r.client.UpdateRecord(ctx, "FieldPermissions", permission.ID, map[string]any{
+				"PermissionsRead": access.read,
+				"PermissionsEdit": access.edit,
+			})
+		}
+		if err != nil {
+			diags.AddError(
+				"Unable to Update Salesforce Field-Level Security",
+				fmt.Sprintf("Setting the access of %q to %s failed: %s", access.permissionSet.Name, field, err.Error()),
+			)
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// managedFieldAccess returns the access of the managed permission sets found
+// in reported. Permission sets without a FieldPermissions record have no
+// access.
+func managedFieldAccess(managed, reported map[string]fieldAccessModel) map[string]fieldAccessModel {
+	result := map[string]fieldAccessModel{}
+	for name := range managed {
+		access := fieldAccessModel{Read: types.BoolValue(false), Edit: types.BoolValue(false)}
+		for reportedName, reportedAccess := range reported {
+			if strings.EqualFold(name, reportedName) {
+				access = reportedAccess
+			}
+		}
+		result[name] = access
+	}
+
+	return result
+}
+
+// mergeFieldAccess returns the union of the permission sets of a and b.
+func mergeFieldAccess(a, b map[string]fieldAccessModel) map[string]fieldAccessModel {
+	result := map[string]fieldAccessModel{}
+	for name, access := range b {
+		result[name] = access
+	}
+	for name, access := range a {
+		result[name] = access
+	}
+
+	return result
+}
@@ -0,0 +1,196 @@
+package provider
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccFieldLevelSecurityResource(t *testing.T) {
+	org := newMockSalesforce(t)
+
+	field := `resource "salesforce_custom_object" "training" {
+		api_name     = "vub_Training__c"
+		label        = "Training"
+		plural_label = "Trainings"
+	}
+
+	resource "salesforce_custom_field" "code" {
+		object   = salesforce_custom_object.training.api_name
+		api_name = "vub_Code__c"
+		label    = "Code"
+		type     = "Text"
+		length   = 20
+	}
+	`
+
+	// access returns a check of the FieldPermissions records of the field
+	// by permission set or profile, each as "read,edit".
+	access := func(want map[string]string) resource.TestCheckFunc {
+		return func(*terraform.State) error {
+			got := map[string]string{}
+			for name, permission := range org.fieldAccess("vub_Training__c.vub_Code__c") {
+				got[name] = fmt.Sprintf("%t,%t", permission.PermissionsRead, permission.PermissionsEdit)
+			}
+			if !reflect.DeepEqual(got, want) {
+				return fmt.Errorf("field access = %v, want %v", got, want)
+			}
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             access(map[string]string{}),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: org.providerConfig() + field + `resource "salesforce_field_level_security" "test" {
+					field = salesforce_custom_field.code.id
+					permission_sets = {
+						vub_Integration = { read = true }
+					}
+					profiles = {
+						"Standard User" = { read = true, edit = true }
+					}
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("salesforce_field_level_security.test", "id", "vub_Training__c.vub_Code__c"),
+					resource.TestCheckResourceAttr("salesforce_field_level_security.test", "permission_sets.vub_Integration.edit", "false"),
+					resource.TestCheckResourceAttr("salesforce_field_level_security.test", "profiles.Standard User.edit", "true"),
+					access(map[string]string{"vub_Integration": "true,false", "Standard User": "true,true"}),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "salesforce_field_level_security.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing, removing a profile revokes its access
+			{
+				Config: org.providerConfig() + field + `resource "salesforce_field_level_security" "test" {
+					field = salesforce_custom_field.code.id
+					permission_sets = {
+						vub_Integration = { read = true, edit = true }
+					}
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("salesforce_field_level_security.test", "permission_sets.vub_Integration.edit", "true"),
+					resource.TestCheckNoResourceAttr("salesforce_field_level_security.test", "profiles.%"),
+					access(map[string]string{"vub_Integration": "true,true"}),
+				),
+			},
+			// Revoking read access deletes the record
+			{
+				Config: org.providerConfig() + field + `resource "salesforce_field_level_security" "test" {
+					field = salesforce_custom_field.code.id
+					permission_sets = {
+						vub_Integration = { read = false }
+					}
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("salesforce_field_level_security.test", "permission_sets.vub_Integration.read", "false"),
+					access(map[string]string{}),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestFieldLevelSecurityResourceValidateConfig(t *testing.T) {
+	access := func(read, edit any) func(tftypes.Type) tftypes.Value {
+		return func(accessesType tftypes.Type) tftypes.Value {
+			accessType := accessesType.(tftypes.Map).ElementType
+			return tftypes.NewValue(accessesType, map[string]tftypes.Value{
+				"vub_Integration": tftypes.NewValue(accessType, map[string]tftypes.Value{
+					"read": tftypes.NewValue(tftypes.Bool, read),
+					"edit": tftypes.NewValue(tftypes.Bool, edit),
+				}),
+			})
+		}
+	}
+
+	tests := map[string]struct {
+		values  map[string]any
+		wantErr string
+	}{
+		"unknown permission sets": {
+			values: map[string]any{"permission_sets": tftypes.UnknownValue},
+		},
+		"unknown read access": {
+			values: map[string]any{"permission_sets": access(tftypes.UnknownValue, true)},
+		},
+		"edit without read": {
+			values:  map[string]any{"profiles": access(false, true)},
+			wantErr: "Invalid Field-Level Security",
+		},
+		"no access": {
+			wantErr: "Missing Field-Level Security",
+		},
+		"field without object": {
+			values:  map[string]any{"field": "vub_Code__c", "profiles": access(true, false)},
+			wantErr: "Invalid Field Name",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			values := map[string]any{"field": "vub_Training__c.vub_Code__c"}
+			for attribute, value := range test.values {
+				values[attribute] = value
+			}
+
+			diags := validateResourceConfig(t, &fieldLevelSecurityResource{}, values)
+
+			if test.wantErr == "" && diags.HasError() {
+				t.Fatalf("ValidateConfig() diagnostics = %v", diags)
+			}
+			if test.wantErr != "" && (!diags.HasError() || diags.Errors()[0].Summary() != test.wantErr) {
+				t.Fatalf("ValidateConfig() diagnostics = %v, want %q", diags, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestMergeFieldAccess(t *testing.T) {
+	readOnly := fieldAccessModel{Read: types.BoolValue(true), Edit: types.BoolValue(false)}
+	readWrite := fieldAccessModel{Read: types.BoolValue(true), Edit: types.BoolValue(true)}
+
+	tests := map[string]struct {
+		a, b map[string]fieldAccessModel
+		want map[string]fieldAccessModel
+	}{
+		"both nil": {
+			want: map[string]fieldAccessModel{},
+		},
+		"only prior": {
+			b:    map[string]fieldAccessModel{"vub_Integration": readOnly},
+			want: map[string]fieldAccessModel{"vub_Integration": readOnly},
+		},
+		"union": {
+			a:    map[string]fieldAccessModel{"vub_Integration": readOnly},
+			b:    map[string]fieldAccessModel{"vub_Reporting": readWrite},
+			want: map[string]fieldAccessModel{"vub_Integration": readOnly, "vub_Reporting": readWrite},
+		},
+		"a wins": {
+			a:    map[string]fieldAccessModel{"vub_Integration": readWrite},
+			b:    map[string]fieldAccessModel{"vub_Integration": readOnly},
+			want: map[string]fieldAccessModel{"vub_Integration": readWrite},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := mergeFieldAccess(test.a, test.b); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("mergeFieldAccess() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,716 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/villeroy-boch/terraform-provider-salesforce/internal/salesforce"
+)
+
+// mockFieldTypes maps the types of custom fields to the types the describe
+// endpoint reports.
+var mockFieldTypes = map[string]string{
+	salesforce.CustomFieldTypeCheckbox:            "boolean",
+	salesforce.CustomFieldTypeCurrency:            "currency",
+	salesforce.CustomFieldTypeDate:                "date",
+	salesforce.CustomFieldTypeDateTime:            "datetime",
+	salesforce.CustomFieldTypeEmail:               "email",
+	salesforce.CustomFieldTypeLongTextArea:        "textarea",
+	salesforce.CustomFieldTypeLookup:              "reference",
+	salesforce.CustomFieldTypeMasterDetail:        "reference",
+	salesforce.CustomFieldTypeMultiselectPicklist: "multipicklist",
+	salesforce.CustomFieldTypeNumber:              "double",
+	salesforce.CustomFieldTypePercent:             "percent",
+	salesforce.CustomFieldTypePhone:               "phone",
+	salesforce.CustomFieldTypePicklist:            "picklist",
+	salesforce.CustomFieldTypeSummary:             "double",
+	salesforce.CustomFieldTypeText:                "string",
+	salesforce.CustomFieldTypeTextArea:            "textarea",
+	salesforce.CustomFieldTypeURL:                 "url",
+}
+
+// mockFixtures is the directory of the canned responses of the nginx mock
+// server. The mock org answers the requests it keeps no state for with them,
+// e.g. the describe results of standard objects and the query of queues.
+const mockFixtures = "../../mock-server/static"
+
+// Patterns of the conditions of the SOQL queries the mock org answers.
+var (
+	mockNameConditionPattern  = regexp.MustCompile(`(Profile\.)?Name IN \(([^)]*)\)`)
+	mockFieldConditionPattern = regexp.MustCompile(`Field = '([^']*)'`)
+	mockStringPattern         = regexp.MustCompile(`'([^']*)'`)
+)
+
+// mockSalesforce is an org kept in memory for acceptance tests. Unlike the
+// nginx server of the mock-server directory, it keeps the changes of earlier
+// test steps, so tests can update, import and destroy resources. Requests it
+// keeps no state for are answered with the fixtures of the nginx server.
+type mockSalesforce struct {
+	*httptest.Server
+
+	mu sync.Mutex
+	// components holds the XML content of Metadata API components by type
+	// and full name.
+	components map[string]map[string][]byte
+	// permissionSets are the PermissionSet records, including the one of
+	// the profile "Standard User".
+	permissionSets []salesforce.PermissionSet
+	// fieldPermissions holds the FieldPermissions records by ID.
+	fieldPermissions map[string]salesforce.FieldPermissions
+	// records holds the sObject records by type and ID.
+	records map[string]map[string]map[string]any
+	lastID  int
+}
+
+// newMockSalesforce starts a mock org that is stopped when the test ends.
+func newMockSalesforce(t *testing.T) *mockSalesforce {
+	t.Helper()
+
+	m := &mockSalesforce{
+		components:       map[string]map[string][]byte{},
+		fieldPermissions: map[string]salesforce.FieldPermissions{},
+		records:          map[string]map[string]map[string]any{},
+	}
+	m.permissionSets = []salesforce.PermissionSet{
+		{ID: "0PS000000000001AAA", Name: "vub_Integration"},
+		{ID: "0PS000000000002AAA", Name: "X00e000000000001AAA", IsOwnedByProfile: true},
+	}
+	m.permissionSets[1].Profile = &struct {
+		Name string `json:"Name"`
+	}{Name: "Standard User"}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/services/Soap/m/59.0", m.serveMetadata)
+	mux.HandleFunc("/services/data/v59.0/sobjects/", m.serveSObjects)
+	mux.HandleFunc("/services/data/v59.0/query", m.serveQuery)
+	mux.HandleFunc("/services/data/v59.0/queryAll", m.serveQuery)
+	mux.HandleFunc("/services/data/v59.0/query/", m.serveFixture)
+	mux.HandleFunc("/services/data/v59.0/composite/batch", m.serveFixture)
+	m.Server = httptest.NewServer(mux)
+	t.Cleanup(m.Close)
+
+	return m
+}
+
+// providerConfig returns the provider configuration connecting to the mock
+// org with an access token, so no OAuth flow is needed.
+func (m *mockSalesforce) providerConfig() string {
+	return fmt.Sprintf(`
+provider "salesforce" {
+  api_host     = %[1]q
+  api_version  = "v59.0"
+  access_token = "00Dxx0000001gPL!mock"
+  instance_url = %[1]q
+}
+`, m.URL)
+}
+
+// client returns a client of the API of the mock org.
+func (m *mockSalesforce) client(t *testing.T) *salesforce.Client {
+	t.Helper()
+
+	apiHost, apiVersion := m.URL, "v59.0"
+	client, err := salesforce.NewClient(context.Background(), &apiHost, &apiVersion,
+		salesforce.StaticTokenSource(&salesforce.Token{AccessToken: "00Dxx0000001gPL!mock", InstanceURL: m.URL}))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	return client
+}
+
+// component decodes the stored component of metadataType with fullName
+// into component and reports whether it exists.
+func (m *mockSalesforce) component(metadataType, fullName string, component any) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	content, ok := m.components[metadataType][fullName]
+	if !ok {
+		return false
+	}
+	err := xml.Unmarshal(append(append([]byte("<records>"), content...), "</records>"...), component)
+
+	return err == nil
+}
+
+// exists returns a check that the component of metadataType with fullName
+// exists, or with exists false, that it does not.
+func (m *mockSalesforce) exists(metadataType, fullName string, exists bool) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		if _, ok := m.components[metadataType][fullName]; ok != exists {
+			return fmt.Errorf("%s %s exists: %t, want %t", metadataType, fullName, ok, exists)
+		}
+
+		return nil
+	}
+}
+
+// fieldAccess returns the FieldPermissions records of field by name of the
+// permission set, or of the profile owning it.
+func (m *mockSalesforce) fieldAccess(field string) map[string]salesforce.FieldPermissions {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	result := map[string]salesforce.FieldPermissions{}
+	for _, permission := range m.fieldPermissions {
+		if permission.Field != field {
+			continue
+		}
+		permissionSet := m.permissionSet(permission.ParentID)
+		if profile := permissionSet.ProfileName(); profile != "" {
+			result[profile] = permission
+		} else {
+			result[permissionSet.Name] = permission
+		}
+	}
+
+	return result
+}
+
+// permissionSet returns the permission set with id.
+func (m *mockSalesforce) permissionSet(id string) *salesforce.PermissionSet {
+	for i := range m.permissionSets {
+		if m.permissionSets[i].ID == id {
+			return &m.permissionSets[i]
+		}
+	}
+
+	return nil
+}
+
+// record returns the fields of the record of sObjectType with id, or nil if
+// it does not exist.
+func (m *mockSalesforce) record(sObjectType, id string) map[string]any {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	record, ok := m.records[sObjectType][id]
+	if !ok {
+		return nil
+	}
+	fields := map[string]any{}
+	for name, value := range record {
+		fields[name] = value
+	}
+
+	return fields
+}
+
+// recordIDs returns the IDs of the records of sObjectType.
+func (m *mockSalesforce) recordIDs(sObjectType string) []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return sortedKeys(m.records[sObjectType])
+}
+
+// createRecord adds a record of sObjectType with the fields and returns its
+// ID, e.g. to set up a record created outside of Terraform.
+func (m *mockSalesforce) createRecord(sObjectType string, fields map[string]any) string {
+	keyPrefix := "a00"
+	if description, ok := m.description(sObjectType); ok && description.KeyPrefix != nil {
+		keyPrefix = *description.KeyPrefix
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.saveRecord(sObjectType, keyPrefix, "", fields)
+}
+
+// saveRecord sets the fields of the record of sObjectType with id, which
+// is created with an ID starting with keyPrefix if id is empty.
+func (m *mockSalesforce) saveRecord(sObjectType, keyPrefix, id string, fields map[string]any) string {
+	if id == "" {
+		m.lastID++
+		id = fmt.Sprintf("%s%012dAAA", keyPrefix, m.lastID)
+	}
+	if m.records[sObjectType] == nil {
+		m.records[sObjectType] = map[string]map[string]any{}
+	}
+	if m.records[sObjectType][id] == nil {
+		m.records[sObjectType][id] = map[string]any{}
+	}
+	for name, value := range fields {
+		m.records[sObjectType][id][name] = value
+	}
+
+	return id
+}
+
+// serveMetadata answers the CRUD calls of the Metadata API.
+func (m *mockSalesforce) serveMetadata(w http.ResponseWriter, r *http.Request) {
+	envelope := struct {
+		Body struct {
+			Operation struct {
+				XMLName   xml.Name
+				Type      string   `xml:"type"`
+				FullNames []string `xml:"fullNames"`
+				Metadata  []struct {
+					Type     string `xml:"http://www.w3.org/2001/XMLSchema-instance type,attr"`
+					FullName string `xml:"fullName"`
+					Content  []byte `xml:",innerxml"`
+				} `xml:"metadata"`
+			} `xml:",any"`
+		} `xml:"Body"`
+	}{}
+	if err := xml.NewDecoder(r.Body).Decode(&envelope); err != nil {
+		writeSOAPFault(w, "INVALID_XML", err.Error())
+		return
+	}
+	operation := envelope.Body.Operation
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var content any
+	switch operation.XMLName.Local {
+	case "createMetadata", "updateMetadata":
+		results := []salesforce.MetadataSaveResult{}
+		for _, component := range operation.Metadata {
+			if m.components[component.Type] == nil {
+				m.components[component.Type] = map[string][]byte{}
+			}
+			_, exists := m.components[component.Type][component.FullName]
+
+			switch {
+			case operation.XMLName.Local == "createMetadata" && exists:
+				results = append(results, failedSaveResult(component.FullName, "DUPLICATE_DEVELOPER_NAME",
+					fmt.Sprintf("There is already a %s named %s.", component.Type, component.FullName)))
+			case operation.XMLName.Local == "updateMetadata" && !exists:
+				results = append(results, failedSaveResult(component.FullName, "INVALID_CROSS_REFERENCE_KEY",
+					fmt.Sprintf("In field: fullName - no %s named %s found", component.Type, component.FullName)))
+			default:
+				m.components[component.Type][component.FullName] = component.Content
+				results = append(results, salesforce.MetadataSaveResult{FullName: component.FullName, Success: true})
+			}
+		}
+		content = results
+	case "deleteMetadata":
+		results := []salesforce.MetadataSaveResult{}
+		for _, fullName := range operation.FullNames {
+			if _, exists := m.components[operation.Type][fullName]; !exists {
+				results = append(results, failedSaveResult(fullName, "INVALID_CROSS_REFERENCE_KEY",
+					fmt.Sprintf("In field: fullName - no %s named %s found", operation.Type, fullName)))
+				continue
+			}
+			delete(m.components[operation.Type], fullName)
+			// Deleting an object deletes its fields
+			if operation.Type == "CustomObject" {
+				for field := range m.components["CustomField"] {
+					if strings.HasPrefix(field, fullName+".") {
+						delete(m.components["CustomField"], field)
+					}
+				}
+			}
+			results = append(results, salesforce.MetadataSaveResult{FullName: fullName, Success: true})
+		}
+		content = results
+	case "readMetadata":
+		records := ""
+		for _, fullName := range operation.FullNames {
+			if component, exists := m.components[operation.Type][fullName]; exists {
+				records += fmt.Sprintf(`<records xsi:type="%s">%s</records>`, operation.Type, component)
+			} else {
+				records += `<records xsi:nil="true"/>`
+			}
+		}
+		content = struct {
+			Records string `xml:",innerxml"`
+		}{records}
+	default:
+		writeSOAPFault(w, "INVALID_OPERATION", "unsupported operation "+operation.XMLName.Local)
+		return
+	}
+
+	response, err := xml.Marshal(struct {
+		XMLName xml.Name
+		Result  any `xml:"result"`
+	}{XMLName: xml.Name{Local: operation.XMLName.Local + "Response"}, Result: content})
+	if err != nil {
+		writeSOAPFault(w, "UNKNOWN_EXCEPTION", err.Error())
+		return
+	}
+	writeSOAP(w, http.StatusOK, string(response))
+}
+
+// serveSObjects answers describe requests, saves records and
+// FieldPermissions records and lists the sObjects of the fixtures.
+func (m *mockSalesforce) serveSObjects(w http.ResponseWriter, r *http.Request) {
+	sObjectType, action, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/services/data/v59.0/sobjects/"), "/")
+	switch {
+	case sObjectType == "":
+		writeFixture(w, "services/data/v59.0/sobjects/index.json")
+	case r.Method == http.MethodGet && action == "describe":
+		if description, ok := m.customObjectDescription(sObjectType); ok {
+			writeJSON(w, http.StatusOK, description)
+			return
+		}
+		writeFixture(w, r.URL.Path)
+	case sObjectType == "FieldPermissions":
+		m.serveFieldPermissions(w, r, action)
+	default:
+		m.serveRecords(w, r, sObjectType, action)
+	}
+}
+
+// customObjectDescription returns the describe result of a custom object,
+// built from the components of the object and its fields.
+func (m *mockSalesforce) customObjectDescription(sObjectType string) (*salesforce.Description, bool) {
+	var object salesforce.CustomObject
+	if !m.component("CustomObject", sObjectType, &object) {
+		return nil, false
+	}
+
+	description := &salesforce.Description{
+		Name:        object.FullName,
+		Label:       object.Label,
+		LabelPlural: object.PluralLabel,
+		Custom:      true,
+		Fields: []salesforce.DescriptionField{
+			{Name: "Id", Label: "Record ID", Type: "id"},
+		},
+	}
+	if object.NameField != nil {
+		description.Fields = append(description.Fields, salesforce.DescriptionField{
+			Name:       "Name",
+			Label:      object.NameField.Label,
+			Type:       "string",
+			NameField:  true,
+			AutoNumber: object.NameField.Type == nameFieldTypeAutoNumber,
+		})
+	}
+
+	m.mu.Lock()
+	fullNames := sortedKeys(m.components["CustomField"])
+	m.mu.Unlock()
+	for _, fullName := range fullNames {
+		var field salesforce.CustomField
+		if !strings.HasPrefix(fullName, sObjectType+".") || !m.component("CustomField", fullName, &field) {
+			continue
+		}
+		description.Fields = append(description.Fields, salesforce.DescriptionField{
+			Name:           strings.TrimPrefix(fullName, sObjectType+"."),
+			Label:          field.Label,
+			Type:           mockFieldTypes[field.Type],
+			Custom:         true,
+			Permissionable: !field.Required,
+		})
+	}
+
+	return description, true
+}
+
+// description returns the describe result of sObjectType, of a custom
+// object of the org or from the fixtures.
+func (m *mockSalesforce) description(sObjectType string) (*salesforce.Description, bool) {
+	if description, ok := m.customObjectDescription(sObjectType); ok {
+		return description, true
+	}
+
+	content, err := os.ReadFile(filepath.Join(mockFixtures, "services", "data", "v59.0", "sobjects", sObjectType, "describe"))
+	if err != nil {
+		return nil, false
+	}
+	description := &salesforce.Description{}
+	if err := json.Unmarshal(content, description); err != nil {
+		return nil, false
+	}
+
+	return description, true
+}
+
+// serveRecords creates, reads, updates and deletes the records of
+// sObjectType, by ID or by the value of an external ID field in key, e.g.
+// "vub_Key__c/DEFAULT". Like Salesforce, it rejects writes of fields that
+// cannot be created or updated. Records it does not keep are read from the
+// fixtures.
+func (m *mockSalesforce) serveRecords(w http.ResponseWriter, r *http.Request, sObjectType, key string) {
+	description, ok := m.description(sObjectType)
+	if !ok && r.Method == http.MethodGet {
+		writeFixture(w, r.URL.Path)
+		return
+	}
+	if !ok {
+		writeJSONError(w, http.StatusNotFound, salesforce.ErrorCodeNotFound, "The requested resource does not exist")
+		return
+	}
+	keyPrefix := "a00"
+	if description.KeyPrefix != nil {
+		keyPrefix = *description.KeyPrefix
+	}
+
+	var fields map[string]any
+	if r.Method == http.MethodPost || r.Method == http.MethodPatch {
+		if err := json.NewDecoder(r.Body).Decode(&fields); err != nil {
+			writeJSONError(w, http.StatusBadRequest, "JSON_PARSER_ERROR", err.Error())
+			return
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// Find the record by ID or by external ID
+	id, externalID, byExternalID := strings.Cut(key, "/")
+	var externalIDField *salesforce.DescriptionField
+	if byExternalID {
+		field, ok := description.Field(id)
+		if !ok || !(field.ExternalID || field.IDLookup) {
+			writeJSONError(w, http.StatusBadRequest, salesforce.ErrorCodeNotFound, fmt.Sprintf("Provided external ID field does not exist or is not accessible: %s", id))
+			return
+		}
+		externalIDField = field
+		id = ""
+		for _, recordID := range sortedKeys(m.records[sObjectType]) {
+			if value, ok := m.records[sObjectType][recordID][field.Name]; ok && fmt.Sprint(value) == externalID {
+				id = recordID
+			}
+		}
+	}
+	record, exists := m.records[sObjectType][id]
+
+	// saveFields checks the fields of the request and saves them
+	saveFields := func(creating bool) bool {
+		saved := map[string]any{}
+		for name, value := range fields {
+			field, ok := description.Field(name)
+			if !ok {
+				writeJSONError(w, http.StatusBadRequest, salesforce.ErrorCodeInvalidField,
+					fmt.Sprintf("No such column '%s' on sobject of type %s", name, sObjectType))
+				return false
+			}
+			if creating && !field.Createable || !creating && !field.Updateable {
+				writeJSONError(w, http.StatusBadRequest, "INVALID_FIELD_FOR_INSERT_UPDATE",
+					fmt.Sprintf("Unable to create/update fields: %s. Please check the security settings of this field and verify that it is read/write for your profile or permission set.", field.Name))
+				return false
+			}
+			saved[field.Name] = value
+		}
+		if creating && externalIDField != nil {
+			saved[externalIDField.Name] = externalID
+		}
+		id = m.saveRecord(sObjectType, keyPrefix, id, saved)
+
+		return true
+	}
+
+	switch {
+	case r.Method == http.MethodPost && key == "":
+		if saveFields(true) {
+			writeJSON(w, http.StatusCreated, salesforce.SaveResult{ID: id, Success: true, Errors: []salesforce.ErrorDetail{}})
+		}
+	case r.Method == http.MethodPatch && byExternalID:
+		if saveFields(!exists) {
+			statusCode := http.StatusOK
+			if !exists {
+				statusCode = http.StatusCreated
+			}
+			writeJSON(w, statusCode, salesforce.SaveResult{ID: id, Success: true, Created: !exists, Errors: []salesforce.ErrorDetail{}})
+		}
+	case r.Method == http.MethodGet && !exists:
+		writeFixture(w, r.URL.Path)
+	case !exists:
+		writeJSONError(w, http.StatusNotFound, salesforce.ErrorCodeNotFound, "The requested resource does not exist")
+	case r.Method == http.MethodGet:
+		response := map[string]any{
+			"attributes": map[string]string{"type": sObjectType, "url": "/services/data/v59.0/sobjects/" + sObjectType + "/" + id},
+			"Id":         id,
+		}
+		if selected := r.URL.Query().Get("fields"); selected != "" {
+			for _, name := range strings.Split(selected, ",") {
+				if field, ok := description.Field(name); ok {
+					response[field.Name] = record[field.Name]
+				}
+			}
+		} else {
+			for name, value := range record {
+				response[name] = value
+			}
+		}
+		writeJSON(w, http.StatusOK, response)
+	case r.Method == http.MethodPatch:
+		if saveFields(false) {
+			w.WriteHeader(http.StatusNoContent)
+		}
+	case r.Method == http.MethodDelete:
+		delete(m.records[sObjectType], id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeJSONError(w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "HTTP Method '"+r.Method+"' not allowed")
+	}
+}
+
+// serveFieldPermissions creates, updates and deletes FieldPermissions
+// records. Like Salesforce, it rejects a second record of a permission set
+// for the same field.
+func (m *mockSalesforce) serveFieldPermissions(w http.ResponseWriter, r *http.Request, id string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	permission, exists := m.fieldPermissions[id]
+	switch {
+	case r.Method == http.MethodPost && id == "":
+		if err := json.NewDecoder(r.Body).Decode(&permission); err != nil {
+			writeJSONError(w, http.StatusBadRequest, "JSON_PARSER_ERROR", err.Error())
+			return
+		}
+		if m.permissionSet(permission.ParentID) == nil {
+			writeJSONError(w, http.StatusBadRequest, "INVALID_CROSS_REFERENCE_KEY", "invalid cross reference id")
+			return
+		}
+		for _, other := range m.fieldPermissions {
+			if other.ParentID == permission.ParentID && other.Field == permission.Field {
+				writeJSONError(w, http.StatusBadRequest, "DUPLICATE_VALUE", "duplicate value found: duplicates value on record with id: "+other.ID)
+				return
+			}
+		}
+		m.lastID++
+		permission.ID = fmt.Sprintf("01k%015d", m.lastID)
+		m.fieldPermissions[permission.ID] = permission
+		writeJSON(w, http.StatusCreated, salesforce.SaveResult{ID: permission.ID, Success: true, Errors: []salesforce.ErrorDetail{}})
+	case !exists:
+		writeJSONError(w, http.StatusNotFound, salesforce.ErrorCodeNotFound, "The requested resource does not exist")
+	case r.Method == http.MethodPatch:
+		if err := json.NewDecoder(r.Body).Decode(&permission); err != nil {
+			writeJSONError(w, http.StatusBadRequest, "JSON_PARSER_ERROR", err.Error())
+			return
+		}
+		m.fieldPermissions[id] = permission
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodDelete:
+		delete(m.fieldPermissions, id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeJSONError(w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "HTTP Method '"+r.Method+"' not allowed")
+	}
+}
+
+// serveQuery answers the queries of permission sets by name or profile, of
+// the FieldPermissions records of a field and, from the fixtures, of queues.
+func (m *mockSalesforce) serveQuery(w http.ResponseWriter, r *http.Request) {
+	soql := r.URL.Query().Get("q")
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var records []any
+	switch {
+	case strings.Contains(soql, " FROM PermissionSet "):
+		names := map[string]bool{}
+		profiles := map[string]bool{}
+		for _, condition := range mockNameConditionPattern.FindAllStringSubmatch(soql, -1) {
+			for _, literal := range mockStringPattern.FindAllStringSubmatch(condition[2], -1) {
+				if condition[1] != "" {
+					profiles[strings.ToLower(literal[1])] = true
+				} else {
+					names[strings.ToLower(literal[1])] = true
+				}
+			}
+		}
+		for _, permissionSet := range m.permissionSets {
+			if names[strings.ToLower(permissionSet.Name)] || profiles[strings.ToLower(permissionSet.ProfileName())] {
+				records = append(records, permissionSet)
+			}
+		}
+	case strings.Contains(soql, " FROM FieldPermissions "):
+		condition := mockFieldConditionPattern.FindStringSubmatch(soql)
+		for _, id := range sortedKeys(m.fieldPermissions) {
+			permission := m.fieldPermissions[id]
+			if condition != nil && permission.Field == condition[1] {
+				permission.Parent = m.permissionSet(permission.ParentID)
+				records = append(records, permission)
+			}
+		}
+	case strings.Contains(soql, " FROM Group "):
+		writeFixture(w, "services/data/v59.0/query/index.json")
+		return
+	default:
+		writeJSONError(w, http.StatusBadRequest, "MALFORMED_QUERY", "unsupported query: "+soql)
+		return
+	}
+
+	result := salesforce.QueryResult{TotalSize: len(records), Done: true, Records: []json.RawMessage{}}
+	for _, record := range records {
+		raw, err := json.Marshal(record)
+		if err != nil {
+			writeJSONError(w, http.StatusInternalServerError, "UNKNOWN_EXCEPTION", err.Error())
+			return
+		}
+		result.Records = append(result.Records, raw)
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+// serveFixture answers a request with the fixture at its path.
+func (m *mockSalesforce) serveFixture(w http.ResponseWriter, r *http.Request) {
+	writeFixture(w, r.URL.Path)
+}
+
+// failedSaveResult returns the result of a component Salesforce rejected.
+func failedSaveResult(fullName, statusCode, message string) salesforce.MetadataSaveResult {
+	return salesforce.MetadataSaveResult{
+		FullName: fullName,
+		Errors:   []salesforce.MetadataError{{StatusCode: statusCode, Message: message}},
+	}
+}
+
+// writeSOAP writes content wrapped in a SOAP envelope.
+func writeSOAP(w http.ResponseWriter, statusCode int, content string) {
+	w.Header().Set("Content-Type", "text/xml;charset=UTF-8")
+	w.WriteHeader(statusCode)
+	_, _ = fmt.Fprintf(w, `%s<soapenv:Envelope xmlns:soapenv="http://schemas.xmlsoap.org/soap/envelope/" `+
+		`xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xmlns="http://soap.sforce.com/2006/04/metadata">`+
+		`<soapenv:Body>%s</soapenv:Body></soapenv:Envelope>`, xml.Header, content)
+}
+
+// writeSOAPFault writes a fault failing the whole call.
+func writeSOAPFault(w http.ResponseWriter, code, message string) {
+	var escaped strings.Builder
+	_ = xml.EscapeText(&escaped, []byte(message))
+	writeSOAP(w, http.StatusInternalServerError,
+		fmt.Sprintf(`<soapenv:Fault><faultcode>sf:%s</faultcode><faultstring>%s</faultstring></soapenv:Fault>`, code, escaped.String()))
+}
+
+// writeJSON writes value as JSON response of the REST API.
+func writeJSON(w http.ResponseWriter, statusCode int, value any) {
+	w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(value)
+}
+
+// writeJSONError writes an error response of the REST API.
+func writeJSONError(w http.ResponseWriter, statusCode int, errorCode, message string) {
+	writeJSON(w, statusCode, []salesforce.ErrorDetail{{ErrorCode: errorCode, Message: message}})
+}
+
+// writeFixture writes the fixture with name, a path below mockFixtures, as
+// JSON response of the REST API.
+func writeFixture(w http.ResponseWriter, name string) {
+	content, err := os.ReadFile(filepath.Join(mockFixtures, filepath.FromSlash(strings.TrimPrefix(name, "/"))))
+	if err != nil {
+		writeJSONError(w, http.StatusNotFound, salesforce.ErrorCodeNotFound, "The requested resource does not exist")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(content)
+}
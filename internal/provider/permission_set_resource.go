@@ -0,0 +1,740 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/villeroy-boch/terraform-provider-salesforce/internal/salesforce"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &permissionSetResource{}
+	_ resource.ResourceWithConfigure      = &permissionSetResource{}
+	_ resource.ResourceWithImportState    = &permissionSetResource{}
+	_ resource.ResourceWithValidateConfig = &permissionSetResource{}
+)
+
+// permissionSetAPINamePattern matches the API names Salesforce accepts:
+// letters, digits and single underscores, starting with a letter.
+var permissionSetAPINamePattern = regexp.MustCompile(`^[A-Za-z](?:[A-Za-z0-9]|_[A-Za-z0-9])*$`)
+
+// NewPermissionSetResource is a helper function to simplify the provider implementation.
+func NewPermissionSetResource() resource.Resource {
+	return &permissionSetResource{}
+}
+
+// permissionSetResource is the resource implementation.
+type permissionSetResource struct {
+	client *salesforce.Client
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *permissionSetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring Salesforce Permission Set resource")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*salesforce.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *salesforce.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+
+	tflog.Info(ctx, "Configured Salesforce Permission Set resource", map[string]any{"success": true})
+}
+
+// Metadata returns the resource type name.
+func (r *permissionSetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_permission_set"
+}
+
+// Schema defines the schema for the resource.
+func (r *permissionSetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+
+	nameSet := func(description string) schema.SetAttribute {
+		return schema.SetAttribute{
+			ElementType: types.StringType,
+			Description: description,
+			Optional:    true,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a permission set through the Metadata API. The permission set is managed as a whole: " +
+			"permissions granted outside of Terraform show up as drift and are revoked on the next apply.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "API name of the permission set.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"api_name": schema.StringAttribute{
+				Description: "API name of the permission set, e.g. `vub_Integration`. Changing it replaces the permission set.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"label": schema.StringAttribute{
+				Description: "Label of the permission set.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the permission set.",
+				Optional:    true,
+			},
+			"license": schema.StringAttribute{
+				Description: "User license the permission set requires, e.g. `Salesforce`. Without a license it can be assigned to users of any license. " +
+					"Changing it replaces the permission set.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_permissions":         nameSet("Enabled system permissions, e.g. `ApiEnabled` or `ViewSetup`."),
+			"class_accesses":           nameSet("Apex classes that can be run."),
+			"page_accesses":            nameSet("Visualforce pages that can be opened."),
+			"custom_permissions":       nameSet("Enabled custom permissions."),
+			"record_type_visibilities": nameSet("Record types that can be assigned, by object and record type, e.g. `Account.Business`."),
+		},
+		Blocks: map[string]schema.Block{
+			"object_permissions": schema.SetNestedBlock{
+				Description: "Access to the records of an object. Omitted permissions are not granted.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"object": schema.StringAttribute{
+							Description: "API name of the object.",
+							Required:    true,
+						},
+						"allow_create": schema.BoolAttribute{
+							Description: "Whether records can be created, which needs `allow_read`.",
+							Optional:    true,
+						},
+						"allow_read": schema.BoolAttribute{
+							Description: "Whether records can be read.",
+							Optional:    true,
+						},
+						"allow_edit": schema.BoolAttribute{
+							Description: "Whether records can be edited, which needs `allow_read`.",
+							Optional:    true,
+						},
+						"allow_delete": schema.BoolAttribute{
+							Description: "Whether records can be deleted, which needs `allow_read` and `allow_edit`.",
+							Optional:    true,
+						},
+						"view_all_records": schema.BoolAttribute{
+							Description: "Whether all records can be read regardless of sharing, which needs `allow_read`.",
+							Optional:    true,
+						},
+						"modify_all_records": schema.BoolAttribute{
+							Description: "Whether all records can be edited and deleted regardless of sharing, which needs all other permissions.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"field_permissions": schema.SetNestedBlock{
+				Description: "Access to a field.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"field": schema.StringAttribute{
+							Description: "API names of the object and the field joined by a dot, e.g. `Account.vub_Region__c`.",
+							Required:    true,
+						},
+						"readable": schema.BoolAttribute{
+							Description: "Whether the field can be read.",
+							Optional:    true,
+						},
+						"editable": schema.BoolAttribute{
+							Description: "Whether the field can be edited, which needs `readable`.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"tab_settings": schema.SetNestedBlock{
+				Description: "Visibility of a tab.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"tab": schema.StringAttribute{
+							Description: "Name of the tab, e.g. `standard-Account` or `vub_Training__c`.",
+							Required:    true,
+						},
+						"visibility": schema.StringAttribute{
+							Description: "Either `Visible`, shown in the navigation, or `Available`, found in the app launcher.",
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// permissionSetResourceModel maps the resource schema data.
+type permissionSetResourceModel struct {
+	ID                     types.String                    `tfsdk:"id"`
+	APIName                types.String                    `tfsdk:"api_name"`
+	Label                  types.String                    `tfsdk:"label"`
+	Description            types.String                    `tfsdk:"description"`
+	License                types.String                    `tfsdk:"license"`
+	UserPermissions        []types.String                  `tfsdk:"user_permissions"`
+	ClassAccesses          []types.String                  `tfsdk:"class_accesses"`
+	PageAccesses           []types.String                  `tfsdk:"page_accesses"`
+	CustomPermissions      []types.String                  `tfsdk:"custom_permissions"`
+	RecordTypeVisibilities []types.String                  `tfsdk:"record_type_visibilities"`
+	ObjectPermissions      []permissionSetObjectModel      `tfsdk:"object_permissions"`
+	FieldPermissions       []permissionSetFieldAccessModel `tfsdk:"field_permissions"`
+	TabSettings            []permissionSetTabModel         `tfsdk:"tab_settings"`
+}
+
+type permissionSetObjectModel struct {
+	Object           types.String `tfsdk:"object"`
+	AllowCreate      types.Bool   `tfsdk:"allow_create"`
+	AllowRead        types.Bool   `tfsdk:"allow_read"`
+	AllowEdit        types.Bool   `tfsdk:"allow_edit"`
+	AllowDelete      types.Bool   `tfsdk:"allow_delete"`
+	ViewAllRecords   types.Bool   `tfsdk:"view_all_records"`
+	ModifyAllRecords types.Bool   `tfsdk:"modify_all_records"`
+}
+
+type permissionSetFieldAccessModel struct {
+	Field    types.String `tfsdk:"field"`
+	Readable types.Bool   `tfsdk:"readable"`
+	Editable types.Bool   `tfsdk:"editable"`
+}
+
+type permissionSetTabModel struct {
+	Tab        types.String `tfsdk:"tab"`
+	Visibility types.String `tfsdk:"visibility"`
+}
+
+// permissionSetConfigModel maps the configuration ValidateConfig checks. The
+// sets are kept as framework values, as they may be unknown while
+// validating, e.g. when built with for expressions or dynamic blocks.
+type permissionSetConfigModel struct {
+	ID                     types.String `tfsdk:"id"`
+	APIName                types.String `tfsdk:"api_name"`
+	Label                  types.String `tfsdk:"label"`
+	Description            types.String `tfsdk:"description"`
+	License                types.String `tfsdk:"license"`
+	UserPermissions        types.Set    `tfsdk:"user_permissions"`
+	ClassAccesses          types.Set    `tfsdk:"class_accesses"`
+	PageAccesses           types.Set    `tfsdk:"page_accesses"`
+	CustomPermissions      types.Set    `tfsdk:"custom_permissions"`
+	RecordTypeVisibilities types.Set    `tfsdk:"record_type_visibilities"`
+	ObjectPermissions      types.Set    `tfsdk:"object_permissions"`
+	FieldPermissions       types.Set    `tfsdk:"field_permissions"`
+	TabSettings            types.Set    `tfsdk:"tab_settings"`
+}
+
+// ValidateConfig checks the dependencies between permissions, which
+// Salesforce enforces when saving the permission set.
+func (r *permissionSetResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config permissionSetConfigModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.APIName.IsUnknown() && !permissionSetAPINamePattern.MatchString(config.APIName.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_name"),
+			"Invalid Permission Set API Name",
+			fmt.Sprintf("The API name must start with a letter and contain only letters, digits and single underscores, got: %q", config.APIName.ValueString()),
+		)
+	}
+
+	// Unknown blocks and permissions are checked once they are known
+	objectPermissions, diags := knownElements[permissionSetObjectModel](ctx, config.ObjectPermissions)
+	resp.Diagnostics.Append(diags...)
+	fieldPermissions, diags := knownElements[permissionSetFieldAccessModel](ctx, config.FieldPermissions)
+	resp.Diagnostics.Append(diags...)
+	tabSettings, diags := knownElements[permissionSetTabModel](ctx, config.TabSettings)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	missing := func(permission types.Bool) bool {
+		return !permission.IsUnknown() && !permission.ValueBool()
+	}
+
+	objects := map[string]bool{}
+	for _, permissions := range objectPermissions {
+		if permissions.Object.IsUnknown() {
+			continue
+		}
+		object := permissions.Object.ValueString()
+		if objects[strings.ToLower(object)] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("object_permissions"),
+				"Duplicate Permissions",
+				fmt.Sprintf("The object %q has more than one object_permissions block.", object),
+			)
+		}
+		objects[strings.ToLower(object)] = true
+
+		needs := func(permission string, granted bool, required ...string) {
+			if granted {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("object_permissions"),
+					"Invalid Object Permissions",
+					fmt.Sprintf("%s of %q needs %s too.", permission, object, strings.Join(required, ", ")),
+				)
+			}
+		}
+		noRead, noEdit, noDelete, noViewAll := missing(permissions.AllowRead), missing(permissions.AllowEdit),
+			missing(permissions.AllowDelete), missing(permissions.ViewAllRecords)
+		needs("allow_create", permissions.AllowCreate.ValueBool() && noRead, "allow_read")
+		needs("allow_edit", permissions.AllowEdit.ValueBool() && noRead, "allow_read")
+		needs("allow_delete", permissions.AllowDelete.ValueBool() && (noRead || noEdit), "allow_read", "allow_edit")
+		needs("view_all_records", permissions.ViewAllRecords.ValueBool() && noRead, "allow_read")
+		needs("modify_all_records", permissions.ModifyAllRecords.ValueBool() && (noRead || noEdit || noDelete || noViewAll),
+			"allow_read", "allow_edit", "allow_delete", "view_all_records")
+	}
+
+	fields := map[string]bool{}
+	for _, permissions := range fieldPermissions {
+		if permissions.Field.IsUnknown() {
+			continue
+		}
+		field := permissions.Field.ValueString()
+		if fields[strings.ToLower(field)] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("field_permissions"),
+				"Duplicate Permissions",
+				fmt.Sprintf("The field %q has more than one field_permissions block.", field),
+			)
+		}
+		fields[strings.ToLower(field)] = true
+
+		if permissions.Editable.ValueBool() && missing(permissions.Readable) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("field_permissions"),
+				"Invalid Field Permissions",
+				fmt.Sprintf("editable of %q needs readable too.", field),
+			)
+		}
+	}
+
+	tabs := map[string]bool{}
+	for _, setting := range tabSettings {
+		if setting.Tab.IsUnknown() {
+			continue
+		}
+		if tabs[strings.ToLower(setting.Tab.ValueString())] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("tab_settings"),
+				"Duplicate Permissions",
+				fmt.Sprintf("The tab %q has more than one tab_settings block.", setting.Tab.ValueString()),
+			)
+		}
+		tabs[strings.ToLower(setting.Tab.ValueString())] = true
+
+		validateOneOf(&resp.Diagnostics, path.Root("tab_settings"), setting.Visibility,
+			salesforce.TabVisibilityVisible, salesforce.TabVisibilityAvailable)
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *permissionSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan permissionSetResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.SaveMetadata(ctx, plan.permissionSet(permissionSetResourceModel{}), true)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Salesforce Permission Set",
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = plan.APIName
+
+	// Set state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the permissions the org reports.
+func (r *permissionSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state permissionSetResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permissionSet, err := r.client.GetPermissionSet(ctx, state.ID.ValueString())
+	if salesforce.IsNotFound(err) {
+		tflog.Warn(ctx, "Salesforce permission set no longer exists, removing it from state", map[string]any{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Salesforce Permission Set",
+			err.Error(),
+		)
+		return
+	}
+
+	state.update(permissionSet)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *permissionSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state permissionSetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.SaveMetadata(ctx, plan.permissionSet(state), false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Salesforce Permission Set",
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = plan.APIName
+
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *permissionSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state permissionSetResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Permission sets already deleted outside of Terraform are gone as planned
+	err := r.client.DeleteComponent(ctx, "PermissionSet", state.ID.ValueString())
+	if err != nil && !salesforce.IsNotFound(err) && !salesforce.IsComponentNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Salesforce Permission Set",
+			err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports a permission set by its API name.
+func (r *permissionSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("api_name"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// permissionSet converts the model into the Metadata API component. The
+// permissions only granted in prior are revoked explicitly, as deployments
+// of permission sets keep permissions they do not mention.
+func (m permissionSetResourceModel) permissionSet(prior permissionSetResourceModel) salesforce.PermissionSetMetadata {
+	permissionSet := salesforce.PermissionSetMetadata{
+		FullName:    m.APIName.ValueString(),
+		Description: m.Description.ValueString(),
+		Label:       m.Label.ValueString(),
+		License:     m.License.ValueString(),
+	}
+
+	userPermissions := namedPermissions(m.UserPermissions, prior.UserPermissions)
+	for _, name := range sortedKeys(userPermissions) {
+		permissionSet.UserPermissions = append(permissionSet.UserPermissions, salesforce.PermissionSetUserPermission{Enabled: userPermissions[name], Name: name})
+	}
+	classAccesses := namedPermissions(m.ClassAccesses, prior.ClassAccesses)
+	for _, name := range sortedKeys(classAccesses) {
+		permissionSet.ClassAccesses = append(permissionSet.ClassAccesses, salesforce.ApexClassAccess{ApexClass: name, Enabled: classAccesses[name]})
+	}
+	pageAccesses := namedPermissions(m.PageAccesses, prior.PageAccesses)
+	for _, name := range sortedKeys(pageAccesses) {
+		permissionSet.PageAccesses = append(permissionSet.PageAccesses, salesforce.ApexPageAccess{ApexPage: name, Enabled: pageAccesses[name]})
+	}
+	customPermissions := namedPermissions(m.CustomPermissions, prior.CustomPermissions)
+	for _, name := range sortedKeys(customPermissions) {
+		permissionSet.CustomPermissions = append(permissionSet.CustomPermissions, salesforce.PermissionSetCustomPermission{Enabled: customPermissions[name], Name: name})
+	}
+	recordTypes := namedPermissions(m.RecordTypeVisibilities, prior.RecordTypeVisibilities)
+	for _, name := range sortedKeys(recordTypes) {
+		permissionSet.RecordTypeVisibilities = append(permissionSet.RecordTypeVisibilities, salesforce.PermissionSetRecordTypeVisibility{RecordType: name, Visible: recordTypes[name]})
+	}
+
+	objects := map[string]bool{}
+	for _, permissions := range m.ObjectPermissions {
+		objects[strings.ToLower(permissions.Object.ValueString())] = true
+		permissionSet.ObjectPermissions = append(permissionSet.ObjectPermissions, salesforce.PermissionSetObjectPermissions{
+			AllowCreate:      permissions.AllowCreate.ValueBool(),
+			AllowDelete:      permissions.AllowDelete.ValueBool(),
+			AllowEdit:        permissions.AllowEdit.ValueBool(),
+			AllowRead:        permissions.AllowRead.ValueBool(),
+			ModifyAllRecords: permissions.ModifyAllRecords.ValueBool(),
+			Object:           permissions.Object.ValueString(),
+			ViewAllRecords:   permissions.ViewAllRecords.ValueBool(),
+		})
+	}
+	for _, permissions := range prior.ObjectPermissions {
+		if !objects[strings.ToLower(permissions.Object.ValueString())] {
+			permissionSet.ObjectPermissions = append(permissionSet.ObjectPermissions, salesforce.PermissionSetObjectPermissions{
+				Object: permissions.Object.ValueString(),
+			})
+		}
+	}
+
+	fields := map[string]bool{}
+	for _, permissions := range m.FieldPermissions {
+		fields[strings.ToLower(permissions.Field.ValueString())] = true
+		permissionSet.FieldPermissions = append(permissionSet.FieldPermissions, salesforce.PermissionSetFieldPermissions{
+			Editable: permissions.Editable.ValueBool(),
+			Field:    permissions.Field.ValueString(),
+			Readable: permissions.Readable.ValueBool(),
+		})
+	}
+	for _, permissions := range prior.FieldPermissions {
+		if !fields[strings.ToLower(permissions.Field.ValueString())] {
+			permissionSet.FieldPermissions = append(permissionSet.FieldPermissions, salesforce.PermissionSetFieldPermissions{
+				Field: permissions.Field.ValueString(),
+			})
+		}
+	}
+
+	tabs := map[string]bool{}
+	for _, setting := range m.TabSettings {
+		tabs[strings.ToLower(setting.Tab.ValueString())] = true
+		permissionSet.TabSettings = append(permissionSet.TabSettings, salesforce.PermissionSetTabSetting{
+			Tab:        setting.Tab.ValueString(),
+			Visibility: setting.Visibility.ValueString(),
+		})
+	}
+	for _, setting := range prior.TabSettings {
+		if !tabs[strings.ToLower(setting.Tab.ValueString())] {
+			permissionSet.TabSettings = append(permissionSet.TabSettings, salesforce.PermissionSetTabSetting{
+				Tab:        setting.Tab.ValueString(),
+				Visibility: salesforce.TabVisibilityNone,
+			})
+		}
+	}
+
+	return permissionSet
+}
+
+// update sets the model to the permission set as read from Salesforce.
+// Permissions that grant nothing are left out, flags that are not granted
+// stay null if they were null.
+func (m *permissionSetResourceModel) update(permissionSet *salesforce.PermissionSetMetadata) {
+	m.ID = types.StringValue(permissionSet.FullName)
+	m.APIName = types.StringValue(permissionSet.FullName)
+	m.Label = types.StringValue(permissionSet.Label)
+	m.Description = optionalStringValue(permissionSet.Description)
+	m.License = optionalStringValue(permissionSet.License)
+
+	names := []string{}
+	for _, permission := range permissionSet.UserPermissions {
+		if permission.Enabled {
+			names = append(names, permission.Name)
+		}
+	}
+	m.UserPermissions = permissionNameValues(names, m.UserPermissions)
+
+	names = []string{}
+	for _, access := range permissionSet.ClassAccesses {
+		if access.Enabled {
+			names = append(names, access.ApexClass)
+		}
+	}
+	m.ClassAccesses = permissionNameValues(names, m.ClassAccesses)
+
+	names = []string{}
+	for _, access := range permissionSet.PageAccesses {
+		if access.Enabled {
+			names = append(names, access.ApexPage)
+		}
+	}
+	m.PageAccesses = permissionNameValues(names, m.PageAccesses)
+
+	names = []string{}
+	for _, permission := range permissionSet.CustomPermissions {
+		if permission.Enabled {
+			names = append(names, permission.Name)
+		}
+	}
+	m.CustomPermissions = permissionNameValues(names, m.CustomPermissions)
+
+	names = []string{}
+	for _, visibility := range permissionSet.RecordTypeVisibilities {
+		if visibility.Visible {
+			names = append(names, visibility.RecordType)
+		}
+	}
+	m.RecordTypeVisibilities = permissionNameValues(names, m.RecordTypeVisibilities)
+
+	priorObjects := map[string]permissionSetObjectModel{}
+	for _, permissions := range m.ObjectPermissions {
+		priorObjects[strings.ToLower(permissions.Object.ValueString())] = permissions
+	}
+	m.ObjectPermissions = []permissionSetObjectModel{}
+	for _, permissions := range permissionSet.ObjectPermissions {
+		if !(permissions.AllowCreate || permissions.AllowRead || permissions.AllowEdit || permissions.AllowDelete ||
+			permissions.ViewAllRecords || permissions.ModifyAllRecords) {
+			continue
+		}
+		prior := priorObjects[strings.ToLower(permissions.Object)]
+		m.ObjectPermissions = append(m.ObjectPermissions, permissionSetObjectModel{
+			Object:           priorName(permissions.Object, prior.Object),
+			AllowCreate:      optionalBoolValue(permissions.AllowCreate, prior.AllowCreate),
+			AllowRead:        optionalBoolValue(permissions.AllowRead, prior.AllowRead),
+			AllowEdit:        optionalBoolValue(permissions.AllowEdit, prior.AllowEdit),
+			AllowDelete:      optionalBoolValue(permissions.AllowDelete, prior.AllowDelete),
+			ViewAllRecords:   optionalBoolValue(permissions.ViewAllRecords, prior.ViewAllRecords),
+			ModifyAllRecords: optionalBoolValue(permissions.ModifyAllRecords, prior.ModifyAllRecords),
+		})
+	}
+
+	priorFields := map[string]permissionSetFieldAccessModel{}
+	for _, permissions := range m.FieldPermissions {
+		priorFields[strings.ToLower(permissions.Field.ValueString())] = permissions
+	}
+	m.FieldPermissions = []permissionSetFieldAccessModel{}
+	for _, permissions := range permissionSet.FieldPermissions {
+		if !permissions.Readable && !permissions.Editable {
+			continue
+		}
+		prior := priorFields[strings.ToLower(permissions.Field)]
+		m.FieldPermissions = append(m.FieldPermissions, permissionSetFieldAccessModel{
+			Field:    priorName(permissions.Field, prior.Field),
+			Readable: optionalBoolValue(permissions.Readable, prior.Readable),
+			Editable: optionalBoolValue(permissions.Editable, prior.Editable),
+		})
+	}
+
+	priorTabs := map[string]permissionSetTabModel{}
+	for _, setting := range m.TabSettings {
+		priorTabs[strings.ToLower(setting.Tab.ValueString())] = setting
+	}
+	m.TabSettings = []permissionSetTabModel{}
+	for _, setting := range permissionSet.TabSettings {
+		if setting.Visibility == salesforce.TabVisibilityNone {
+			continue
+		}
+		m.TabSettings = append(m.TabSettings, permissionSetTabModel{
+			Tab:        priorName(setting.Tab, priorTabs[strings.ToLower(setting.Tab)].Tab),
+			Visibility: types.StringValue(setting.Visibility),
+		})
+	}
+}
+
+// namedPermissions returns the names of the permissions granted in planned
+// as enabled and the ones only granted in prior as disabled.
+func namedPermissions(planned, prior []types.String) map[string]bool {
+	result := map[string]bool{}
+	for _, name := range prior {
+		result[name.ValueString()] = false
+	}
+	for _, name := range planned {
+		for priorName := range result {
+			if strings.EqualFold(priorName, name.ValueString()) {
+				delete(result, priorName)
+			}
+		}
+		result[name.ValueString()] = true
+	}
+
+	return result
+}
+
+// permissionNameValues converts the names of granted permissions, keeping
+// the spelling of names in prior. No names leave a null set null.
+func permissionNameValues(names []string, prior []types.String) []types.String {
+	if len(names) == 0 && prior == nil {
+		return nil
+	}
+
+	result := make([]types.String, 0, len(names))
+	for _, name := range names {
+		value := types.StringValue(name)
+		for _, priorValue := range prior {
+			if strings.EqualFold(priorValue.ValueString(), name) {
+				value = priorValue
+			}
+		}
+		result = append(result, value)
+	}
+
+	return result
+}
+
+// priorName returns prior if it is name spelled differently, so case
+// differences of API names do not show up as drift.
+func priorName(name string, prior types.String) types.String {
+	if strings.EqualFold(prior.ValueString(), name) && !prior.IsNull() {
+		return prior
+	}
+
+	return types.StringValue(name)
+}
+
+// knownElements decodes the known elements of a set of objects into models.
+// Unknown sets and elements are left out.
+func knownElements[T any](ctx context.Context, set types.Set) ([]T, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	models := []T{}
+	for _, element := range set.Elements() {
+		object, ok := element.(types.Object)
+		if !ok || object.IsUnknown() {
+			continue
+		}
+
+		var model T
+		diags.Append(object.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+		models = append(models, model)
+	}
+
+	return models, diags
+}
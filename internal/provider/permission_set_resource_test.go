@@ -0,0 +1,350 @@
+package provider
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/villeroy-boch/terraform-provider-salesforce/internal/salesforce"
+)
+
+func TestAccPermissionSetResource(t *testing.T) {
+	org := newMockSalesforce(t)
+
+	// stored returns a check of the stored component: its description, its
+	// user permissions by name and its field permissions as "read,edit".
+	stored := func(description string, userPermissions map[string]bool, fieldPermissions map[string]string) resource.TestCheckFunc {
+		return func(*terraform.State) error {
+			var permissionSet salesforce.PermissionSetMetadata
+			if !org.component("PermissionSet", "vub_Reporting", &permissionSet) {
+				return fmt.Errorf("PermissionSet vub_Reporting does not exist")
+			}
+			if permissionSet.Description != description {
+				return fmt.Errorf("description = %q, want %q", permissionSet.Description, description)
+			}
+
+			gotUserPermissions := map[string]bool{}
+			for _, permission := range permissionSet.UserPermissions {
+				gotUserPermissions[permission.Name] = permission.Enabled
+			}
+			if !reflect.DeepEqual(gotUserPermissions, userPermissions) {
+				return fmt.Errorf("user permissions = %v, want %v", gotUserPermissions, userPermissions)
+			}
+
+			gotFieldPermissions := map[string]string{}
+			for _, permission := range permissionSet.FieldPermissions {
+				gotFieldPermissions[permission.Field] = fmt.Sprintf("%t,%t", permission.Readable, permission.Editable)
+			}
+			if !reflect.DeepEqual(gotFieldPermissions, fieldPermissions) {
+				return fmt.Errorf("field permissions = %v, want %v", gotFieldPermissions, fieldPermissions)
+			}
+
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             org.exists("PermissionSet", "vub_Reporting", false),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: org.providerConfig() + `resource "salesforce_permission_set" "test" {
+					api_name         = "vub_Reporting"
+					label            = "Reporting"
+					description      = "Reports on trainings"
+					user_permissions = ["ApiEnabled", "RunReports"]
+
+					object_permissions {
+						object     = "vub_Training__c"
+						allow_read = true
+						allow_edit = true
+					}
+
+					field_permissions {
+						field    = "vub_Training__c.vub_Code__c"
+						readable = true
+					}
+
+					tab_settings {
+						tab        = "vub_Training__c"
+						visibility = "Visible"
+					}
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("salesforce_permission_set.test", "id", "vub_Reporting"),
+					resource.TestCheckResourceAttr("salesforce_permission_set.test", "description", "Reports on trainings"),
+					resource.TestCheckResourceAttr("salesforce_permission_set.test", "user_permissions.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("salesforce_permission_set.test", "object_permissions.*", map[string]string{
+						"object":     "vub_Training__c",
+						"allow_read": "true",
+						"allow_edit": "true",
+					}),
+					resource.TestCheckNoResourceAttr("salesforce_permission_set.test", "class_accesses"),
+					stored("Reports on trainings",
+						map[string]bool{"ApiEnabled": true, "RunReports": true},
+						map[string]string{"vub_Training__c.vub_Code__c": "true,false"}),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "salesforce_permission_set.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing, removed permissions are revoked
+			{
+				Config: org.providerConfig() + `resource "salesforce_permission_set" "test" {
+					api_name         = "vub_Reporting"
+					label            = "Reporting"
+					description      = "Reports on trainings and courses"
+					user_permissions = ["ApiEnabled"]
+
+					object_permissions {
+						object     = "vub_Training__c"
+						allow_read = true
+					}
+
+					tab_settings {
+						tab        = "vub_Training__c"
+						visibility = "Available"
+					}
+				}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("salesforce_permission_set.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("salesforce_permission_set.test", "description", "Reports on trainings and courses"),
+					resource.TestCheckResourceAttr("salesforce_permission_set.test", "user_permissions.#", "1"),
+					resource.TestCheckResourceAttr("salesforce_permission_set.test", "field_permissions.#", "0"),
+					resource.TestCheckTypeSetElemNestedAttrs("salesforce_permission_set.test", "tab_settings.*", map[string]string{
+						"tab":        "vub_Training__c",
+						"visibility": "Available",
+					}),
+					stored("Reports on trainings and courses",
+						map[string]bool{"ApiEnabled": true, "RunReports": false},
+						map[string]string{"vub_Training__c.vub_Code__c": "false,false"}),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "salesforce_permission_set.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestPermissionSetResourceValidateConfig(t *testing.T) {
+	// blocks returns a set of blocks with the attribute values, unset
+	// attributes are null and nil blocks are unknown.
+	blocks := func(blocks ...map[string]any) func(tftypes.Type) tftypes.Value {
+		return func(setType tftypes.Type) tftypes.Value {
+			objectType := setType.(tftypes.Set).ElementType.(tftypes.Object)
+			elements := []tftypes.Value{}
+			for _, values := range blocks {
+				if values == nil {
+					elements = append(elements, tftypes.NewValue(objectType, tftypes.UnknownValue))
+					continue
+				}
+				attributes := map[string]tftypes.Value{}
+				for name, attributeType := range objectType.AttributeTypes {
+					attributes[name] = tftypes.NewValue(attributeType, values[name])
+				}
+				elements = append(elements, tftypes.NewValue(objectType, attributes))
+			}
+			return tftypes.NewValue(setType, elements)
+		}
+	}
+
+	tests := map[string]struct {
+		values  map[string]any
+		wantErr string
+	}{
+		"unknown user permissions": {
+			values: map[string]any{"user_permissions": tftypes.UnknownValue},
+		},
+		"unknown object permissions": {
+			values: map[string]any{"object_permissions": tftypes.UnknownValue},
+		},
+		"unknown object permissions block": {
+			values: map[string]any{"object_permissions": blocks(nil, map[string]any{"object": "Account", "allow_read": true})},
+		},
+		"unknown allow_read": {
+			values: map[string]any{"object_permissions": blocks(map[string]any{
+				"object": "Account", "allow_read": tftypes.UnknownValue, "allow_edit": true, "allow_delete": true,
+			})},
+		},
+		"allow_edit without allow_read": {
+			values:  map[string]any{"object_permissions": blocks(map[string]any{"object": "Account", "allow_edit": true})},
+			wantErr: "Invalid Object Permissions",
+		},
+		"unknown readable": {
+			values: map[string]any{"field_permissions": blocks(map[string]any{
+				"field": "Account.vub_Region__c", "readable": tftypes.UnknownValue, "editable": true,
+			})},
+		},
+		"editable without readable": {
+			values: map[string]any{"field_permissions": blocks(map[string]any{
+				"field": "Account.vub_Region__c", "readable": false, "editable": true,
+			})},
+			wantErr: "Invalid Field Permissions",
+		},
+		"duplicate tabs": {
+			values: map[string]any{"tab_settings": blocks(
+				map[string]any{"tab": "standard-Account", "visibility": "Visible"},
+				map[string]any{"tab": "standard-account", "visibility": "Available"},
+			)},
+			wantErr: "Duplicate Permissions",
+		},
+		"invalid tab visibility": {
+			values:  map[string]any{"tab_settings": blocks(map[string]any{"tab": "standard-Account", "visibility": "Hidden"})},
+			wantErr: "Invalid Attribute Value",
+		},
+		"invalid API name": {
+			values:  map[string]any{"api_name": "vub__Reporting"},
+			wantErr: "Invalid Permission Set API Name",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, ok := test.values["api_name"]; !ok {
+				test.values["api_name"] = "vub_Reporting"
+			}
+			test.values["label"] = "Reporting"
+
+			diags := validateResourceConfig(t, &permissionSetResource{}, test.values)
+
+			if test.wantErr == "" && diags.HasError() {
+				t.Fatalf("ValidateConfig() diagnostics = %v", diags)
+			}
+			if test.wantErr != "" && (!diags.HasError() || diags.Errors()[0].Summary() != test.wantErr) {
+				t.Fatalf("ValidateConfig() diagnostics = %v, want %q", diags, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestNamedPermissions(t *testing.T) {
+	tests := map[string]struct {
+		planned, prior []types.String
+		want           map[string]bool
+	}{
+		"both nil": {
+			want: map[string]bool{},
+		},
+		"granted": {
+			planned: []types.String{types.StringValue("ApiEnabled")},
+			want:    map[string]bool{"ApiEnabled": true},
+		},
+		"revoked": {
+			planned: []types.String{types.StringValue("ApiEnabled")},
+			prior:   []types.String{types.StringValue("ApiEnabled"), types.StringValue("RunReports")},
+			want:    map[string]bool{"ApiEnabled": true, "RunReports": false},
+		},
+		"spelled differently": {
+			planned: []types.String{types.StringValue("ApiEnabled")},
+			prior:   []types.String{types.StringValue("apienabled")},
+			want:    map[string]bool{"ApiEnabled": true},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := namedPermissions(test.planned, test.prior); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("namedPermissions() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestPermissionNameValues(t *testing.T) {
+	tests := map[string]struct {
+		names []string
+		prior []types.String
+		want  []types.String
+	}{
+		"null set": {},
+		"empty set": {
+			prior: []types.String{},
+			want:  []types.String{},
+		},
+		"revoked": {
+			prior: []types.String{types.StringValue("ApiEnabled")},
+			want:  []types.String{},
+		},
+		"spelling of prior": {
+			names: []string{"ApiEnabled", "RunReports"},
+			prior: []types.String{types.StringValue("apienabled")},
+			want:  []types.String{types.StringValue("apienabled"), types.StringValue("RunReports")},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := permissionNameValues(test.names, test.prior); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("permissionNameValues() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestPriorName(t *testing.T) {
+	tests := map[string]struct {
+		name  string
+		prior types.String
+		want  types.String
+	}{
+		"same spelling": {
+			name:  "Account",
+			prior: types.StringValue("Account"),
+			want:  types.StringValue("Account"),
+		},
+		"spelled differently": {
+			name:  "Account",
+			prior: types.StringValue("account"),
+			want:  types.StringValue("account"),
+		},
+		"other name": {
+			name:  "Account",
+			prior: types.StringValue("Contact"),
+			want:  types.StringValue("Account"),
+		},
+		"null prior": {
+			name:  "Account",
+			prior: types.StringNull(),
+			want:  types.StringValue("Account"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := priorName(test.name, test.prior); !got.Equal(test.want) {
+				t.Errorf("priorName() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestPermissionSetResourceDeleteMissing(t *testing.T) {
+	org := newMockSalesforce(t)
+
+	diags := deleteResource(t, &permissionSetResource{client: org.client(t)}, map[string]any{
+		"id":       "vub_Reporting",
+		"api_name": "vub_Reporting",
+	})
+	if diags.HasError() {
+		t.Errorf("Delete() diagnostics = %v", diags)
+	}
+}
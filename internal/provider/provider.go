@@ -2,9 +2,14 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"os"
+	"path/filepath"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -39,14 +44,45 @@ type salesforceProvider struct {
 
 // salesforceProviderModel maps provider schema data to a Go type.
 type salesforceProviderModel struct {
-	ApiHost      types.String `tfsdk:"api_host"`
-	ApiVersion   types.String `tfsdk:"api_version"`
-	AuthHost     types.String `tfsdk:"auth_host"`
-	ClientID     types.String `tfsdk:"client_id"`
-	ClientSecret types.String `tfsdk:"client_secret"`
-	GrantType    types.String `tfsdk:"grant_type"`
-	Username     types.String `tfsdk:"username"`
-	Password     types.String `tfsdk:"password"`
+	ApiHost        types.String        `tfsdk:"api_host"`
+	ApiVersion     types.String        `tfsdk:"api_version"`
+	AuthHost       types.String        `tfsdk:"auth_host"`
+	ClientID       types.String        `tfsdk:"client_id"`
+	ClientSecret   types.String        `tfsdk:"client_secret"`
+	GrantType      types.String        `tfsdk:"grant_type"`
+	Username       types.String        `tfsdk:"username"`
+	Password       types.String        `tfsdk:"password"`
+	PrivateKey     types.String        `tfsdk:"private_key"`
+	Audience       types.String        `tfsdk:"audience"`
+	AccessToken    types.String        `tfsdk:"access_token"`
+	InstanceURL    types.String        `tfsdk:"instance_url"`
+	RefreshToken   types.String        `tfsdk:"refresh_token"`
+	RequestTimeout types.String        `tfsdk:"request_timeout"`
+	AuthTimeout    types.String        `tfsdk:"auth_timeout"`
+	Retry          *retryModel         `tfsdk:"retry"`
+	APIUsage       *apiUsageModel      `tfsdk:"api_usage"`
+	DescribeCache  *describeCacheModel `tfsdk:"describe_cache"`
+}
+
+// retryModel maps the retry block of the provider schema.
+type retryModel struct {
+	MaxRetries types.Int64  `tfsdk:"max_retries"`
+	MinBackoff types.String `tfsdk:"min_backoff"`
+	MaxBackoff types.String `tfsdk:"max_backoff"`
+	ErrorCodes types.List   `tfsdk:"error_codes"`
+}
+
+// apiUsageModel maps the api_usage block of the provider schema.
+type apiUsageModel struct {
+	Threshold types.Float64 `tfsdk:"threshold"`
+	Action    types.String  `tfsdk:"action"`
+	Delay     types.String  `tfsdk:"delay"`
+}
+
+// describeCacheModel maps the describe_cache block of the provider schema.
+type describeCacheModel struct {
+	Directory types.String `tfsdk:"directory"`
+	MaxAge    types.String `tfsdk:"max_age"`
 }
 
 // Metadata returns the provider type name.
@@ -62,7 +98,7 @@ func (p *salesforceProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 		Attributes: map[string]schema.Attribute{
 			"api_host": schema.StringAttribute{
 				Optional:    true,
-				Description: "URI for Salesforce API. May also be provided via SALESFORCE_API_HOST environment variable.",
+				Description: "URI for Salesforce API. Defaults to the instance URL returned with the access token. May also be provided via SALESFORCE_API_HOST environment variable.",
 			},
 			"api_version": schema.StringAttribute{
 				Optional:    true,
@@ -80,11 +116,11 @@ func (p *salesforceProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 			"client_secret": schema.StringAttribute{
 				Optional:    true,
 				Sensitive:   true,
-				Description: "Client Secret for Salesforce API. May also be provided via SALESFORCE_CLIENT_SECRET environment variable.",
+				Description: "Client Secret for Salesforce API. Also verifies the signature of token responses, which is skipped without a client secret, e.g. for the JWT bearer flow. May also be provided via SALESFORCE_CLIENT_SECRET environment variable.",
 			},
 			"grant_type": schema.StringAttribute{
 				Optional:    true,
-				Description: "Grant type for Salesforce API. May also be provided via SALESFORCE_GRANT_TYPE environment variable.",
+				Description: "Grant type for Salesforce API. One of `password`, `client_credentials` or `urn:ietf:params:oauth:grant-type:jwt-bearer`. May also be provided via SALESFORCE_GRANT_TYPE environment variable.",
 			},
 			"username": schema.StringAttribute{
 				Optional:    true,
@@ -95,6 +131,91 @@ func (p *salesforceProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 				Sensitive:   true,
 				Description: "Password for Salesforce API. May also be provided via SALESFORCE_PASSWORD environment variable.",
 			},
+			"private_key": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "PEM encoded RSA private key, or a path to one, used to sign the JWT bearer assertion. May also be provided via SALESFORCE_PRIVATE_KEY environment variable.",
+			},
+			"audience": schema.StringAttribute{
+				Optional:    true,
+				Description: "Audience of the JWT bearer assertion, e.g. https://login.salesforce.com or https://test.salesforce.com. May also be provided via SALESFORCE_AUDIENCE environment variable.",
+			},
+			"access_token": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Pre-issued access token, e.g. from `sf org display`. When set, no token is requested, the OAuth attributes are ignored and the token is used without signature verification. May also be provided via SALESFORCE_ACCESS_TOKEN environment variable.",
+			},
+			"instance_url": schema.StringAttribute{
+				Optional:    true,
+				Description: "Instance URL the access token was issued for. Used as API host unless api_host is set. May also be provided via SALESFORCE_INSTANCE_URL environment variable.",
+			},
+			"refresh_token": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Refresh token used to renew the access token once the session expires. Requires auth_host and client_id. May also be provided via SALESFORCE_REFRESH_TOKEN environment variable.",
+			},
+			"request_timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: "Timeout of each Salesforce API request, e.g. `30s`. Defaults to `10s`. May also be provided via SALESFORCE_REQUEST_TIMEOUT environment variable.",
+			},
+			"auth_timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: "Timeout of each request for an access token, e.g. `15s`. Defaults to `5s`. May also be provided via SALESFORCE_AUTH_TIMEOUT environment variable.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"api_usage": schema.SingleNestedBlock{
+				Description: "Limits the share of the org's daily API requests a Terraform run may use, as reported by the Sforce-Limit-Info header.",
+				Attributes: map[string]schema.Attribute{
+					"threshold": schema.Float64Attribute{
+						Optional:    true,
+						Description: "Percentage of the daily API request limit after which the action applies, e.g. `80`.",
+					},
+					"action": schema.StringAttribute{
+						Optional:    true,
+						Description: "Either `stop` to fail further requests or `throttle` to delay them. Defaults to `throttle`.",
+					},
+					"delay": schema.StringAttribute{
+						Optional:    true,
+						Description: "Delay before every request once the threshold is passed with action `throttle`. Defaults to `1s`.",
+					},
+				},
+			},
+			"describe_cache": schema.SingleNestedBlock{
+				Description: "Stores describe results on disk and revalidates them with If-Modified-Since instead of fetching them on every run. The cache is enabled by the presence of this block.",
+				Attributes: map[string]schema.Attribute{
+					"directory": schema.StringAttribute{
+						Optional:    true,
+						Description: "Directory the describe results are stored in. Defaults to `terraform-provider-salesforce/describe` in the user's cache directory.",
+					},
+					"max_age": schema.StringAttribute{
+						Optional:    true,
+						Description: "How long a stored result is used without revalidating it, e.g. `1h`. Defaults to `0s`, which revalidates every result.",
+					},
+				},
+			},
+			"retry": schema.SingleNestedBlock{
+				Description: "Retry policy for transient failures. Responses with status 429, 502, 503 or 504 are always retried for GET, PUT and DELETE requests. Other requests such as POST are only retried after status 429 or 503 with one of `error_codes`, as they may already have been applied otherwise.",
+				Attributes: map[string]schema.Attribute{
+					"max_retries": schema.Int64Attribute{
+						Optional:    true,
+						Description: "Maximum number of retries of a request. Defaults to 3, 0 disables retries.",
+					},
+					"min_backoff": schema.StringAttribute{
+						Optional:    true,
+						Description: "Wait before the first retry, doubled for every further retry. Defaults to `1s`.",
+					},
+					"max_backoff": schema.StringAttribute{
+						Optional:    true,
+						Description: "Maximum wait between retries, also applied to Retry-After headers. Defaults to `30s`.",
+					},
+					"error_codes": schema.ListAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+						Description: "Salesforce error codes that are retried. Defaults to `UNABLE_TO_LOCK_ROW` and `SERVER_UNAVAILABLE`. `REQUEST_LIMIT_EXCEEDED` is not retried by default, as the daily API request limit does not reset within the backoff.",
+					},
+				},
+			},
 		},
 	}
 }
@@ -186,6 +307,69 @@ func (p *salesforceProvider) Configure(ctx context.Context, req provider.Configu
 		)
 	}
 
+	if config.PrivateKey.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("private_key"),
+			"Unknown Salesforce Private Key",
+			"The provider cannot create the Salesforce API client as there is an unknown configuration value for the Salesforce private key. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the SALESFORCE_PRIVATE_KEY environment variable.",
+		)
+	}
+
+	if config.Audience.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("audience"),
+			"Unknown Salesforce Audience",
+			"The provider cannot create the Salesforce API client as there is an unknown configuration value for the Salesforce audience. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the SALESFORCE_AUDIENCE environment variable.",
+		)
+	}
+
+	if config.AccessToken.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("access_token"),
+			"Unknown Salesforce Access Token",
+			"The provider cannot create the Salesforce API client as there is an unknown configuration value for the Salesforce access token. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the SALESFORCE_ACCESS_TOKEN environment variable.",
+		)
+	}
+
+	if config.InstanceURL.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("instance_url"),
+			"Unknown Salesforce Instance URL",
+			"The provider cannot create the Salesforce API client as there is an unknown configuration value for the Salesforce instance URL. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the SALESFORCE_INSTANCE_URL environment variable.",
+		)
+	}
+
+	if config.RefreshToken.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("refresh_token"),
+			"Unknown Salesforce Refresh Token",
+			"The provider cannot create the Salesforce API client as there is an unknown configuration value for the Salesforce refresh token. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the SALESFORCE_REFRESH_TOKEN environment variable.",
+		)
+	}
+
+	if config.RequestTimeout.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("request_timeout"),
+			"Unknown Salesforce Request Timeout",
+			"The provider cannot create the Salesforce API client as there is an unknown configuration value for the Salesforce request timeout. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the SALESFORCE_REQUEST_TIMEOUT environment variable.",
+		)
+	}
+
+	if config.AuthTimeout.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("auth_timeout"),
+			"Unknown Salesforce Auth Timeout",
+			"The provider cannot create the Salesforce API client as there is an unknown configuration value for the Salesforce auth timeout. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the SALESFORCE_AUTH_TIMEOUT environment variable.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -201,6 +385,13 @@ func (p *salesforceProvider) Configure(ctx context.Context, req provider.Configu
 	grantType := os.Getenv("SALESFORCE_GRANT_TYPE")
 	username := os.Getenv("SALESFORCE_USERNAME")
 	password := os.Getenv("SALESFORCE_PASSWORD")
+	privateKey := os.Getenv("SALESFORCE_PRIVATE_KEY")
+	audience := os.Getenv("SALESFORCE_AUDIENCE")
+	accessToken := os.Getenv("SALESFORCE_ACCESS_TOKEN")
+	instanceURL := os.Getenv("SALESFORCE_INSTANCE_URL")
+	refreshToken := os.Getenv("SALESFORCE_REFRESH_TOKEN")
+	requestTimeout := os.Getenv("SALESFORCE_REQUEST_TIMEOUT")
+	authTimeout := os.Getenv("SALESFORCE_AUTH_TIMEOUT")
 
 	if !config.ApiHost.IsNull() {
 		apiHost = config.ApiHost.ValueString()
@@ -234,89 +425,235 @@ func (p *salesforceProvider) Configure(ctx context.Context, req provider.Configu
 		password = config.Password.ValueString()
 	}
 
-	// If any of the expected configurations are missing, return
-	// errors with provider-specific guidance.
+	if !config.PrivateKey.IsNull() {
+		privateKey = config.PrivateKey.ValueString()
+	}
 
-	if apiHost == "" {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("api_host"),
-			"Missing Salesforce API Host",
-			"The provider cannot create the Salesforce API client as there is a missing or empty value for the Salesforce API host. "+
-				"Set the host value in the configuration or use the SALESFORCE_API_HOST environment variable. "+
-				"If either is already set, ensure the value is not empty.",
-		)
+	if !config.Audience.IsNull() {
+		audience = config.Audience.ValueString()
+	}
+
+	if !config.AccessToken.IsNull() {
+		accessToken = config.AccessToken.ValueString()
 	}
 
+	if !config.InstanceURL.IsNull() {
+		instanceURL = config.InstanceURL.ValueString()
+	}
+
+	if !config.RefreshToken.IsNull() {
+		refreshToken = config.RefreshToken.ValueString()
+	}
+
+	if !config.RequestTimeout.IsNull() {
+		requestTimeout = config.RequestTimeout.ValueString()
+	}
+
+	if !config.AuthTimeout.IsNull() {
+		authTimeout = config.AuthTimeout.ValueString()
+	}
+
+	// If any of the expected configurations are missing, return
+	// errors with provider-specific guidance.
+
 	if apiVersion == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("api_version"),
 			"Missing Salesforce API Version",
 			"The provider cannot create the Salesforce API client as there is a missing or empty value for the Salesforce API version. "+
-				"Set the host value in the configuration or use the SALESFORCE_API_VERSION environment variable. "+
+				"Set the api_version value in the configuration or use the SALESFORCE_API_VERSION environment variable. "+
 				"If either is already set, ensure the value is not empty.",
 		)
 	}
 
-	if authHost == "" {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("auth_host"),
-			"Missing Salesforce Auth Host",
-			"The provider cannot create the Salesforce API client as there is a missing or empty value for the Salesforce auth host. "+
-				"Set the host value in the configuration or use the SALESFORCE_AUTH_HOST environment variable. "+
-				"If either is already set, ensure the value is not empty.",
-		)
-	}
+	if accessToken != "" {
+		if instanceURL == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("instance_url"),
+				"Missing Salesforce Instance URL",
+				"The provider cannot create the Salesforce API client as there is a missing or empty value for the Salesforce instance URL. "+
+					"An access token is only valid together with the instance URL it was issued for. "+
+					"Set the instance_url value in the configuration or use the SALESFORCE_INSTANCE_URL environment variable. "+
+					"If either is already set, ensure the value is not empty.",
+			)
+		}
 
-	if clientID == "" {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("client_id"),
-			"Missing Salesforce Client ID",
-			"The provider cannot create the Salesforce API client as there is a missing or empty value for the Salesforce client id. "+
-				"Set the host value in the configuration or use the SALESFORCE_CLIENT_ID environment variable. "+
-				"If either is already set, ensure the value is not empty.",
-		)
-	}
+		// Renewing the access token needs the connected app it was issued to.
+		if refreshToken != "" {
+			if authHost == "" {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("auth_host"),
+					"Missing Salesforce Auth Host",
+					"The provider cannot create the Salesforce API client as there is a missing or empty value for the Salesforce auth host. "+
+						"A refresh token can only be used together with the auth host. "+
+						"Set the auth_host value in the configuration or use the SALESFORCE_AUTH_HOST environment variable. "+
+						"If either is already set, ensure the value is not empty.",
+				)
+			}
+
+			if clientID == "" {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("client_id"),
+					"Missing Salesforce Client ID",
+					"The provider cannot create the Salesforce API client as there is a missing or empty value for the Salesforce client id. "+
+						"A refresh token can only be used together with the client id. "+
+						"Set the client_id value in the configuration or use the SALESFORCE_CLIENT_ID environment variable. "+
+						"If either is already set, ensure the value is not empty.",
+				)
+			}
+		}
+	} else {
+		if authHost == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("auth_host"),
+				"Missing Salesforce Auth Host",
+				"The provider cannot create the Salesforce API client as there is a missing or empty value for the Salesforce auth host. "+
+					"Set the auth_host value in the configuration or use the SALESFORCE_AUTH_HOST environment variable. "+
+					"If either is already set, ensure the value is not empty.",
+			)
+		}
 
-	if clientSecret == "" {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("client_secret"),
-			"Missing Salesforce Client Secret",
-			"The provider cannot create the Salesforce API client as there is a missing or empty value for the Salesforce client secret. "+
-				"Set the host value in the configuration or use the SALESFORCE_CLIENT_SECRET environment variable. "+
-				"If either is already set, ensure the value is not empty.",
-		)
-	}
+		if clientID == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("client_id"),
+				"Missing Salesforce Client ID",
+				"The provider cannot create the Salesforce API client as there is a missing or empty value for the Salesforce client id. "+
+					"Set the client_id value in the configuration or use the SALESFORCE_CLIENT_ID environment variable. "+
+					"If either is already set, ensure the value is not empty.",
+			)
+		}
 
-	if grantType == "" {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("grant_type"),
-			"Missing Salesforce Grant Type",
-			"The provider cannot create the Salesforce API client as there is a missing or empty value for the Salesforce grant type. "+
-				"Set the host value in the configuration or use the SALESFORCE_GRANT_TYPE environment variable. "+
-				"If either is already set, ensure the value is not empty.",
-		)
+		if grantType == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("grant_type"),
+				"Missing Salesforce Grant Type",
+				"The provider cannot create the Salesforce API client as there is a missing or empty value for the Salesforce grant type. "+
+					"Set the grant_type value in the configuration or use the SALESFORCE_GRANT_TYPE environment variable. "+
+					"If either is already set, ensure the value is not empty.",
+			)
+		}
+
+		// The remaining credentials depend on the grant type.
+		switch grantType {
+		case "":
+			// Already reported as missing above.
+		case salesforce.GrantTypePassword:
+			if clientSecret == "" {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("client_secret"),
+					"Missing Salesforce Client Secret",
+					"The provider cannot create the Salesforce API client as there is a missing or empty value for the Salesforce client secret. "+
+						"Set the client_secret value in the configuration or use the SALESFORCE_CLIENT_SECRET environment variable. "+
+						"If either is already set, ensure the value is not empty.",
+				)
+			}
+
+			if username == "" {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("username"),
+					"Missing Salesforce Username",
+					"The provider cannot create the Salesforce API client as there is a missing or empty value for the Salesforce username. "+
+						"Set the username value in the configuration or use the SALESFORCE_USERNAME environment variable. "+
+						"If either is already set, ensure the value is not empty.",
+				)
+			}
+
+			if password == "" {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("password"),
+					"Missing Salesforce Password",
+					"The provider cannot create the Salesforce API client as there is a missing or empty value for the Salesforce password. "+
+						"Set the password value in the configuration or use the SALESFORCE_PASSWORD environment variable. "+
+						"If either is already set, ensure the value is not empty.",
+				)
+			}
+		case salesforce.GrantTypeClientCredentials:
+			if clientSecret == "" {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("client_secret"),
+					"Missing Salesforce Client Secret",
+					"The provider cannot create the Salesforce API client as there is a missing or empty value for the Salesforce client secret. "+
+						"Set the client_secret value in the configuration or use the SALESFORCE_CLIENT_SECRET environment variable. "+
+						"If either is already set, ensure the value is not empty.",
+				)
+			}
+		case salesforce.GrantTypeJWTBearer:
+			if username == "" {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("username"),
+					"Missing Salesforce Username",
+					"The provider cannot create the Salesforce API client as there is a missing or empty value for the Salesforce username. "+
+						"Set the username value in the configuration or use the SALESFORCE_USERNAME environment variable. "+
+						"If either is already set, ensure the value is not empty.",
+				)
+			}
+
+			if privateKey == "" {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("private_key"),
+					"Missing Salesforce Private Key",
+					"The provider cannot create the Salesforce API client as there is a missing or empty value for the Salesforce private key. "+
+						"The JWT bearer grant type requires a private key to sign the assertion. "+
+						"Set the private_key value in the configuration or use the SALESFORCE_PRIVATE_KEY environment variable. "+
+						"If either is already set, ensure the value is not empty.",
+				)
+			}
+
+			if audience == "" {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("audience"),
+					"Missing Salesforce Audience",
+					"The provider cannot create the Salesforce API client as there is a missing or empty value for the Salesforce audience. "+
+						"The JWT bearer grant type requires an audience for the assertion. "+
+						"Set the audience value in the configuration or use the SALESFORCE_AUDIENCE environment variable. "+
+						"If either is already set, ensure the value is not empty.",
+				)
+			}
+		default:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("grant_type"),
+				"Unsupported Salesforce Grant Type",
+				"The provider cannot create the Salesforce API client as the Salesforce grant type \""+grantType+"\" is not supported. "+
+					"Use one of \""+salesforce.GrantTypePassword+"\", \""+salesforce.GrantTypeClientCredentials+"\" or \""+salesforce.GrantTypeJWTBearer+"\".",
+			)
+		}
 	}
 
-	if username == "" {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("username"),
-			"Missing Salesforce Username",
-			"The provider cannot create the Salesforce API client as there is a missing or empty value for the Salesforce username. "+
-				"Set the username value in the configuration or use the SALESFORCE_USERNAME environment variable. "+
-				"If either is already set, ensure the value is not empty.",
-		)
+	requestTimeoutDuration := salesforce.DefaultRequestTimeout
+	if requestTimeout != "" {
+		var err error
+		requestTimeoutDuration, err = time.ParseDuration(requestTimeout)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("request_timeout"),
+				"Invalid Salesforce Request Timeout",
+				"The request timeout must be a duration such as \"30s\" or \"2m\": "+err.Error(),
+			)
+		}
 	}
 
-	if password == "" {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("password"),
-			"Missing Salesforce Password",
-			"The provider cannot create the Salesforce API client as there is a missing or empty value for the Salesforce password. "+
-				"Set the password value in the configuration or use the SALESFORCE_PASSWORD environment variable. "+
-				"If either is already set, ensure the value is not empty.",
-		)
+	authTimeoutDuration := salesforce.DefaultAuthTimeout
+	if authTimeout != "" {
+		var err error
+		authTimeoutDuration, err = time.ParseDuration(authTimeout)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("auth_timeout"),
+				"Invalid Salesforce Auth Timeout",
+				"The auth timeout must be a duration such as \"15s\" or \"1m\": "+err.Error(),
+			)
+		}
 	}
 
+	retryPolicy, diags := newRetryPolicy(ctx, config.Retry)
+	resp.Diagnostics.Append(diags...)
+
+	usageLimit, diags := newAPIUsageLimit(config.APIUsage)
+	resp.Diagnostics.Append(diags...)
+
+	describeCache, diags := newDescribeCache(config.DescribeCache)
+	resp.Diagnostics.Append(diags...)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -332,11 +669,85 @@ func (p *salesforceProvider) Configure(ctx context.Context, req provider.Configu
 	ctx = tflog.SetField(ctx, "salesforce_username", username)
 	ctx = tflog.SetField(ctx, "salesforce_password", password)
 	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "salesforce_password")
+	ctx = tflog.SetField(ctx, "salesforce_privateKey", privateKey)
+	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "salesforce_privateKey")
+	ctx = tflog.SetField(ctx, "salesforce_audience", audience)
+	ctx = tflog.SetField(ctx, "salesforce_accessToken", accessToken)
+	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "salesforce_accessToken")
+	ctx = tflog.SetField(ctx, "salesforce_instanceURL", instanceURL)
+	ctx = tflog.SetField(ctx, "salesforce_refreshToken", refreshToken)
+	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "salesforce_refreshToken")
+	ctx = tflog.SetField(ctx, "salesforce_requestTimeout", requestTimeoutDuration.String())
+	ctx = tflog.SetField(ctx, "salesforce_authTimeout", authTimeoutDuration.String())
 
 	tflog.Debug(ctx, "Creating Salesforce client")
 
+	// Choose how the Salesforce client obtains its access token
+	var tokenSource salesforce.TokenSource
+	if accessToken != "" {
+		token := &salesforce.Token{
+			AccessToken: accessToken,
+			InstanceURL: instanceURL,
+		}
+		tokenSource = salesforce.StaticTokenSource(token)
+		if refreshToken != "" {
+			refreshSource, err := salesforce.NewTokenSource(salesforce.AuthStruct{
+				AuthHost:     authHost,
+				ClientID:     clientID,
+				ClientSecret: clientSecret,
+				GrantType:    salesforce.GrantTypeRefreshToken,
+				RefreshToken: refreshToken,
+				Timeout:      authTimeoutDuration,
+			})
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("refresh_token"),
+					"Unable to Create Salesforce Token Source",
+					"An unexpected error occurred when creating the Salesforce token source. "+
+						"If the error is not clear, please contact the provider developers.\n\n"+
+						"Salesforce Client Error: "+err.Error(),
+				)
+				return
+			}
+			tokenSource = salesforce.ReuseTokenSource(token, refreshSource)
+		}
+	} else {
+		var err error
+		tokenSource, err = salesforce.NewTokenSource(salesforce.AuthStruct{
+			AuthHost:     authHost,
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+			GrantType:    grantType,
+			Username:     username,
+			Password:     password,
+			PrivateKey:   privateKey,
+			Audience:     audience,
+			Timeout:      authTimeoutDuration,
+		})
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("grant_type"),
+				"Unable to Create Salesforce Token Source",
+				"An unexpected error occurred when creating the Salesforce token source. "+
+					"If the error is not clear, please contact the provider developers.\n\n"+
+					"Salesforce Client Error: "+err.Error(),
+			)
+			return
+		}
+	}
+
 	// Create a new Salesforce client using the configuration values
-	client, err := salesforce.NewClient(&apiHost, &apiVersion, &authHost, &clientID, &clientSecret, &grantType, &username, &password)
+	client, err := salesforce.NewClient(ctx, &apiHost, &apiVersion, tokenSource)
+	var authErr *salesforce.AuthError
+	if errors.As(err, &authErr) {
+		resp.Diagnostics.AddError(
+			"Unable to Authenticate with Salesforce",
+			"Salesforce rejected the credentials of the provider configuration. "+
+				authErrorAdvice(authErr.ErrorCode)+"\n\n"+
+				"Salesforce Auth Error: "+authErr.Error(),
+		)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create Salesforce API Client",
@@ -347,6 +758,11 @@ func (p *salesforceProvider) Configure(ctx context.Context, req provider.Configu
 		return
 	}
 
+	client.HTTPClient.Timeout = requestTimeoutDuration
+	client.RetryPolicy = retryPolicy
+	client.UsageLimit = usageLimit
+	client.DescribeCache = describeCache
+
 	// Make the Salesforce client available during DataSource and Resource
 	// type Configure methods.
 	resp.DataSourceData = client
@@ -355,14 +771,228 @@ func (p *salesforceProvider) Configure(ctx context.Context, req provider.Configu
 	tflog.Info(ctx, "Configured Salesforce client", map[string]any{"success": true})
 }
 
+// newRetryPolicy applies the retry block of the provider configuration to
+// the default retry policy of the Salesforce client.
+func newRetryPolicy(ctx context.Context, retry *retryModel) (salesforce.RetryPolicy, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	policy := salesforce.DefaultRetryPolicy()
+	if retry == nil {
+		return policy, diags
+	}
+
+	if retry.MaxRetries.IsUnknown() || retry.MinBackoff.IsUnknown() || retry.MaxBackoff.IsUnknown() || retry.ErrorCodes.IsUnknown() {
+		diags.AddAttributeError(
+			path.Root("retry"),
+			"Unknown Salesforce Retry Policy",
+			"The provider cannot create the Salesforce API client as there is an unknown configuration value in the retry block. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+		return policy, diags
+	}
+
+	if !retry.MaxRetries.IsNull() {
+		if retry.MaxRetries.ValueInt64() < 0 {
+			diags.AddAttributeError(
+				path.Root("retry").AtName("max_retries"),
+				"Invalid Salesforce Retry Policy",
+				"The maximum number of retries must not be negative.",
+			)
+		}
+		policy.MaxRetries = int(retry.MaxRetries.ValueInt64())
+	}
+
+	if !retry.MinBackoff.IsNull() {
+		backoff, err := time.ParseDuration(retry.MinBackoff.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("retry").AtName("min_backoff"),
+				"Invalid Salesforce Retry Policy",
+				"The minimum backoff must be a duration such as \"500ms\" or \"2s\": "+err.Error(),
+			)
+		}
+		policy.MinBackoff = backoff
+	}
+
+	if !retry.MaxBackoff.IsNull() {
+		backoff, err := time.ParseDuration(retry.MaxBackoff.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("retry").AtName("max_backoff"),
+				"Invalid Salesforce Retry Policy",
+				"The maximum backoff must be a duration such as \"30s\" or \"1m\": "+err.Error(),
+			)
+		}
+		policy.MaxBackoff = backoff
+	}
+
+	if policy.MaxBackoff > 0 && policy.MinBackoff > policy.MaxBackoff {
+		diags.AddAttributeError(
+			path.Root("retry").AtName("min_backoff"),
+			"Invalid Salesforce Retry Policy",
+			fmt.Sprintf("The minimum backoff %s must not exceed the maximum backoff %s.", policy.MinBackoff, policy.MaxBackoff),
+		)
+	}
+
+	if !retry.ErrorCodes.IsNull() {
+		policy.ErrorCodes = nil
+		diags.Append(retry.ErrorCodes.ElementsAs(ctx, &policy.ErrorCodes, false)...)
+	}
+
+	return policy, diags
+}
+
+// newAPIUsageLimit converts the api_usage block of the provider
+// configuration for the Salesforce client.
+func newAPIUsageLimit(apiUsage *apiUsageModel) (salesforce.APIUsageLimit, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	limit := salesforce.APIUsageLimit{
+		Action: salesforce.APIUsageActionThrottle,
+		Delay:  time.Second,
+	}
+	if apiUsage == nil {
+		return limit, diags
+	}
+
+	if apiUsage.Threshold.IsUnknown() || apiUsage.Action.IsUnknown() || apiUsage.Delay.IsUnknown() {
+		diags.AddAttributeError(
+			path.Root("api_usage"),
+			"Unknown Salesforce API Usage Limit",
+			"The provider cannot create the Salesforce API client as there is an unknown configuration value in the api_usage block. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+		return limit, diags
+	}
+
+	if !apiUsage.Threshold.IsNull() {
+		limit.Threshold = apiUsage.Threshold.ValueFloat64()
+		if limit.Threshold <= 0 || limit.Threshold > 100 {
+			diags.AddAttributeError(
+				path.Root("api_usage").AtName("threshold"),
+				"Invalid Salesforce API Usage Limit",
+				"The threshold must be a percentage greater than 0 and at most 100.",
+			)
+		}
+	}
+
+	if !apiUsage.Action.IsNull() {
+		limit.Action = apiUsage.Action.ValueString()
+		if limit.Action != salesforce.APIUsageActionStop && limit.Action != salesforce.APIUsageActionThrottle {
+			diags.AddAttributeError(
+				path.Root("api_usage").AtName("action"),
+				"Invalid Salesforce API Usage Limit",
+				"The action must be either \""+salesforce.APIUsageActionStop+"\" or \""+salesforce.APIUsageActionThrottle+"\".",
+			)
+		}
+	}
+
+	if !apiUsage.Delay.IsNull() {
+		delay, err := time.ParseDuration(apiUsage.Delay.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("api_usage").AtName("delay"),
+				"Invalid Salesforce API Usage Limit",
+				"The delay must be a duration such as \"500ms\" or \"5s\": "+err.Error(),
+			)
+		}
+		limit.Delay = delay
+	}
+
+	return limit, diags
+}
+
+// newDescribeCache converts the describe_cache block of the provider
+// configuration into the describe cache of the Salesforce client, which is
+// nil without the block.
+func newDescribeCache(describeCache *describeCacheModel) (*salesforce.DescribeCache, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if describeCache == nil {
+		return nil, diags
+	}
+
+	if describeCache.Directory.IsUnknown() || describeCache.MaxAge.IsUnknown() {
+		diags.AddAttributeError(
+			path.Root("describe_cache"),
+			"Unknown Salesforce Describe Cache",
+			"The provider cannot create the Salesforce API client as there is an unknown configuration value in the describe_cache block. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+		return nil, diags
+	}
+
+	cache := &salesforce.DescribeCache{}
+
+	if !describeCache.Directory.IsNull() {
+		cache.Dir = describeCache.Directory.ValueString()
+	} else {
+		userCacheDir, err := os.UserCacheDir()
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("describe_cache").AtName("directory"),
+				"Missing Salesforce Describe Cache Directory",
+				"The user's cache directory is unknown, set the directory of the describe cache explicitly: "+err.Error(),
+			)
+		}
+		cache.Dir = filepath.Join(userCacheDir, "terraform-provider-salesforce", "describe")
+	}
+
+	if !describeCache.MaxAge.IsNull() {
+		maxAge, err := time.ParseDuration(describeCache.MaxAge.ValueString())
+		if err != nil || maxAge < 0 {
+			diags.AddAttributeError(
+				path.Root("describe_cache").AtName("max_age"),
+				"Invalid Salesforce Describe Cache",
+				"The maximum age must be a non-negative duration such as \"30m\" or \"24h\".",
+			)
+		}
+		cache.MaxAge = maxAge
+	}
+
+	return cache, diags
+}
+
+// authErrorAdvice explains how to fix the configuration for an OAuth error
+// code of the Salesforce token endpoint.
+func authErrorAdvice(errorCode string) string {
+	switch errorCode {
+	case salesforce.AuthErrorInvalidGrant:
+		return "Check username and password (including an appended security token), that the user is allowed to log in from this IP address, " +
+			"and for the JWT bearer flow that the user is pre-authorized for the connected app, the certificate matches the private key " +
+			"and the audience matches the login host."
+	case salesforce.AuthErrorInvalidClientID:
+		return "Check that client_id is the consumer key of the connected app and that the app is available in the org behind auth_host."
+	case salesforce.AuthErrorInvalidClient:
+		return "Check that client_secret is the consumer secret of the connected app."
+	case salesforce.AuthErrorUnsupportedGrantType:
+		return "Check that grant_type is enabled for the connected app, e.g. the client credentials flow needs a run-as user."
+	case salesforce.AuthErrorInactiveUser:
+		return "The user is inactive or frozen. Activate the user or configure a different one."
+	case salesforce.AuthErrorInactiveOrg:
+		return "The org is locked or inactive. Check auth_host and the state of the org."
+	case salesforce.AuthErrorRateLimitExceeded:
+		return "Too many logins were attempted in a short time. Wait before retrying."
+	default:
+		return "Check auth_host and the credentials of the provider configuration."
+	}
+}
+
 // DataSources defines the data sources implemented in the provider.
 func (p *salesforceProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewDescriptionDataSource,
+		NewDescriptionsDataSource,
+		NewQueryDataSource,
+		NewRecordDataSource,
+		NewSObjectsDataSource,
 	}
 }
 
 // Resources defines the resources implemented in the provider.
 func (p *salesforceProvider) Resources(_ context.Context) []func() resource.Resource {
-	return nil
+	return []func() resource.Resource{
+		NewCustomFieldResource,
+		NewCustomObjectResource,
+		NewFieldLevelSecurityResource,
+		NewPermissionSetResource,
+		NewRecordResource,
+	}
 }
@@ -1,28 +1,18 @@
 package provider
 
 import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
-)
-
-const (
-	// providerConfig is a shared configuration to combine with the actual
-	// test configuration so the Salesforce client is properly configured.
-	// It is also possible to use the SALESFORCE_ environment variables instead,
-	// such as updating the Makefile and running the testing through that tool.
-	providerConfig = `
-provider "salesforce" {
-  api_host      = "https://xyz.my.salesforce.com"
-  api_version   = "v59.0"
-  auth_host     = "https://login.salesforce.com/services/oauth2/token"
-  client_id     = "idisjisjisjsfjs"
-  client_secret = "sajfaspojfapopsa"
-  grant_type    = "password"
-  username      = "admin"
-  password      = "password123"
-}
-}
-`
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 var (
@@ -34,3 +24,141 @@ var (
 		"salesforce": providerserver.NewProtocol6WithError(New("test")()),
 	}
 )
+
+// resourceValue returns the schema of r and a value of it with the
+// attribute values in values, all other attributes and blocks are null. A
+// value is either passed to tftypes.NewValue with the type of the attribute,
+// or a function building the value from the type, e.g. to leave nested
+// values unknown.
+func resourceValue(t *testing.T, r resource.Resource, values map[string]any) (schema.Schema, tftypes.Value) {
+	t.Helper()
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("Schema() diagnostics = %v", schemaResp.Diagnostics)
+	}
+
+	objectType, ok := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	if !ok {
+		t.Fatal("schema type is not an object")
+	}
+	attributes := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		switch value := values[name].(type) {
+		case func(tftypes.Type) tftypes.Value:
+			attributes[name] = value(attributeType)
+		default:
+			attributes[name] = tftypes.NewValue(attributeType, value)
+		}
+	}
+
+	return schemaResp.Schema, tftypes.NewValue(objectType, attributes)
+}
+
+// validateResourceConfig runs ValidateConfig of r with the configuration
+// of the attribute values in values, see resourceValue.
+func validateResourceConfig(t *testing.T, r resource.ResourceWithValidateConfig, values map[string]any) diag.Diagnostics {
+	t.Helper()
+
+	resourceSchema, config := resourceValue(t, r, values)
+	resp := &resource.ValidateConfigResponse{}
+	r.ValidateConfig(context.Background(), resource.ValidateConfigRequest{
+		Config: tfsdk.Config{Schema: resourceSchema, Raw: config},
+	}, resp)
+
+	return resp.Diagnostics
+}
+
+// deleteResource runs Delete of r with the state of the attribute values in
+// values, see resourceValue.
+func deleteResource(t *testing.T, r resource.Resource, values map[string]any) diag.Diagnostics {
+	t.Helper()
+
+	resourceSchema, state := resourceValue(t, r, values)
+	resp := &resource.DeleteResponse{State: tfsdk.State{Schema: resourceSchema, Raw: state}}
+	r.Delete(context.Background(), resource.DeleteRequest{
+		State: tfsdk.State{Schema: resourceSchema, Raw: state},
+	}, resp)
+
+	return resp.Diagnostics
+}
+
+// modifyResourcePlan returns the response of r to the plan of the values in
+// plan, which update the values in state, or create the resource if state is
+// nil.
+func modifyResourcePlan(t *testing.T, r resource.ResourceWithModifyPlan, state, plan map[string]any) *resource.ModifyPlanResponse {
+	t.Helper()
+
+	resourceSchema, planValue := resourceValue(t, r, plan)
+	stateValue := tftypes.NewValue(planValue.Type(), nil)
+	if state != nil {
+		_, stateValue = resourceValue(t, r, state)
+	}
+	resp := &resource.ModifyPlanResponse{Plan: tfsdk.Plan{Schema: resourceSchema, Raw: planValue}}
+	r.ModifyPlan(context.Background(), resource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: resourceSchema, Raw: planValue},
+		State:  tfsdk.State{Schema: resourceSchema, Raw: stateValue},
+		Plan:   tfsdk.Plan{Schema: resourceSchema, Raw: planValue},
+	}, resp)
+
+	return resp
+}
+
+func TestNewRetryPolicy(t *testing.T) {
+	tests := map[string]struct {
+		retry          *retryModel
+		wantMinBackoff time.Duration
+		wantMaxBackoff time.Duration
+		wantErr        bool
+	}{
+		"defaults": {
+			wantMinBackoff: time.Second,
+			wantMaxBackoff: 30 * time.Second,
+		},
+		"configured": {
+			retry: &retryModel{
+				MaxRetries: types.Int64Null(),
+				MinBackoff: types.StringValue("2s"),
+				MaxBackoff: types.StringValue("1m"),
+				ErrorCodes: types.ListNull(types.StringType),
+			},
+			wantMinBackoff: 2 * time.Second,
+			wantMaxBackoff: time.Minute,
+		},
+		"min above max": {
+			retry: &retryModel{
+				MaxRetries: types.Int64Null(),
+				MinBackoff: types.StringValue("1m"),
+				MaxBackoff: types.StringValue("10s"),
+				ErrorCodes: types.ListNull(types.StringType),
+			},
+			wantErr: true,
+		},
+		"min above default max": {
+			retry: &retryModel{
+				MaxRetries: types.Int64Null(),
+				MinBackoff: types.StringValue("1m"),
+				MaxBackoff: types.StringNull(),
+				ErrorCodes: types.ListNull(types.StringType),
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			policy, diags := newRetryPolicy(context.Background(), test.retry)
+			if diags.HasError() != test.wantErr {
+				t.Fatalf("newRetryPolicy() diagnostics = %v, wantErr %t", diags, test.wantErr)
+			}
+			if test.wantErr {
+				return
+			}
+			if policy.MinBackoff != test.wantMinBackoff || policy.MaxBackoff != test.wantMaxBackoff {
+				t.Errorf("backoff = %s..%s, want %s..%s", policy.MinBackoff, policy.MaxBackoff, test.wantMinBackoff, test.wantMaxBackoff)
+			}
+		})
+	}
+}
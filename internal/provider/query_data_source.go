@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/villeroy-boch/terraform-provider-salesforce/internal/salesforce"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &queryDataSource{}
+	_ datasource.DataSourceWithConfigure = &queryDataSource{}
+)
+
+// NewQueryDataSource is a helper function to simplify the provider implementation.
+func NewQueryDataSource() datasource.DataSource {
+	return &queryDataSource{}
+}
+
+// queryDataSource is the data source implementation.
+type queryDataSource struct {
+	client *salesforce.Client
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *queryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring Salesforce Query data source")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*salesforce.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *salesforce.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+
+	tflog.Info(ctx, "Configured Salesforce Query data source", map[string]any{"success": true})
+}
+
+// Metadata returns the data source type name.
+func (d *queryDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_query"
+}
+
+// Schema defines the schema for the data source.
+func (d *queryDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Description: "Runs a SOQL query and returns all matching records.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the query, the SOQL query itself.",
+				Computed:    true,
+			},
+			"query": schema.StringAttribute{
+				Description: "SOQL query to run, e.g. `SELECT Id FROM Group WHERE Type = 'Queue'`.",
+				Required:    true,
+			},
+			"include_deleted": schema.BoolAttribute{
+				Description: "Also return deleted and archived records. Defaults to `false`.",
+				Optional:    true,
+			},
+			"max_records": schema.Int64Attribute{
+				Description: "Maximum number of records to return. Further batches of results are not fetched once it is reached. Defaults to all records.",
+				Optional:    true,
+			},
+			"total_size": schema.Int64Attribute{
+				Description: "Number of records matching the query, which may exceed the number of returned records.",
+				Computed:    true,
+			},
+			"records": schema.ListAttribute{
+				ElementType: types.StringType,
+				Description: "Records as JSON objects, to be decoded with `jsondecode`.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// queryDataSourceModel maps the data source schema data.
+type queryDataSourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Query          types.String `tfsdk:"query"`
+	IncludeDeleted types.Bool   `tfsdk:"include_deleted"`
+	MaxRecords     types.Int64  `tfsdk:"max_records"`
+	TotalSize      types.Int64  `tfsdk:"total_size"`
+	Records        []string     `tfsdk:"records"`
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *queryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state queryDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Reading Salesforce Query data source", map[string]any{
+		"input": fmt.Sprintf("%+v", state),
+	})
+
+	if state.MaxRecords.ValueInt64() < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_records"),
+			"Invalid Maximum Number of Records",
+			"The maximum number of records must not be negative.",
+		)
+		return
+	}
+
+	result, err := d.client.Query(ctx, state.Query.ValueString(), salesforce.QueryOptions{
+		IncludeDeleted: state.IncludeDeleted.ValueBool(),
+		MaxRecords:     int(state.MaxRecords.ValueInt64()),
+	})
+	if salesforce.IsErrorCode(err, salesforce.ErrorCodeMalformedQuery, salesforce.ErrorCodeInvalidField, salesforce.ErrorCodeInvalidType) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("query"),
+			"Invalid SOQL Query",
+			err.Error(),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Run Salesforce Query",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.Records = []string{}
+	for _, record := range result.Records {
+		state.Records = append(state.Records, string(record))
+	}
+
+	state.ID = state.Query
+	state.TotalSize = types.Int64Value(int64(result.TotalSize))
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccQueryDataSource(t *testing.T) {
+	org := newMockSalesforce(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing, following nextRecordsUrl
+			{
+				Config: org.providerConfig() + `data "salesforce_query" "test" {
+					query = "SELECT Id, DeveloperName FROM Group WHERE Type = 'Queue'"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.salesforce_query.test", "id", "SELECT Id, DeveloperName FROM Group WHERE Type = 'Queue'"),
+					resource.TestCheckResourceAttr("data.salesforce_query.test", "total_size", "3"),
+					resource.TestCheckResourceAttr("data.salesforce_query.test", "records.#", "3"),
+					resource.TestMatchResourceAttr("data.salesforce_query.test", "records.2", regexp.MustCompile(`"DeveloperName":\s*"VuB_Sales_Queue"`)),
+				),
+			},
+			// Limit the number of records
+			{
+				Config: org.providerConfig() + `data "salesforce_query" "test" {
+					query           = "SELECT Id, DeveloperName FROM Group WHERE Type = 'Queue'"
+					include_deleted = true
+					max_records     = 1
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.salesforce_query.test", "total_size", "3"),
+					resource.TestCheckResourceAttr("data.salesforce_query.test", "records.#", "1"),
+					resource.TestMatchResourceAttr("data.salesforce_query.test", "records.0", regexp.MustCompile(`"Id":\s*"00G69000003nWPDEA2"`)),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,208 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/villeroy-boch/terraform-provider-salesforce/internal/salesforce"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &recordDataSource{}
+	_ datasource.DataSourceWithConfigure = &recordDataSource{}
+)
+
+// NewRecordDataSource is a helper function to simplify the provider implementation.
+func NewRecordDataSource() datasource.DataSource {
+	return &recordDataSource{}
+}
+
+// recordDataSource is the data source implementation.
+type recordDataSource struct {
+	client *salesforce.Client
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *recordDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring Salesforce Record data source")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*salesforce.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *salesforce.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+
+	tflog.Info(ctx, "Configured Salesforce Record data source", map[string]any{"success": true})
+}
+
+// Metadata returns the data source type name.
+func (d *recordDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_record"
+}
+
+// Schema defines the schema for the data source.
+func (d *recordDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Description: "Fetches a single record by its ID or by the value of an external ID field.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the record. Either `id` or `external_id_field` and `external_id_value` must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"sobject_type": schema.StringAttribute{
+				Description: "API name of the sObject of the record, e.g. `Pricebook2`.",
+				Required:    true,
+			},
+			"external_id_field": schema.StringAttribute{
+				Description: "API name of an external ID or ID lookup field to find the record by.",
+				Optional:    true,
+			},
+			"external_id_value": schema.StringAttribute{
+				Description: "Value of `external_id_field` of the record.",
+				Optional:    true,
+			},
+			"fields": schema.ListAttribute{
+				ElementType: types.StringType,
+				Description: "API names of the fields to return. Defaults to all fields of the record.",
+				Optional:    true,
+			},
+			"values": schema.MapAttribute{
+				ElementType: types.StringType,
+				Description: "Field values of the record by field API name. Values that are not strings keep their JSON representation, empty fields are null.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// recordDataSourceModel maps the data source schema data.
+type recordDataSourceModel struct {
+	ID              types.String            `tfsdk:"id"`
+	SObjectType     types.String            `tfsdk:"sobject_type"`
+	ExternalIDField types.String            `tfsdk:"external_id_field"`
+	ExternalIDValue types.String            `tfsdk:"external_id_value"`
+	Fields          []string                `tfsdk:"fields"`
+	Values          map[string]types.String `tfsdk:"values"`
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *recordDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state recordDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Reading Salesforce Record data source", map[string]any{
+		"input": fmt.Sprintf("%+v", state),
+	})
+
+	byExternalID := !state.ExternalIDField.IsNull() || !state.ExternalIDValue.IsNull()
+	if byExternalID == !state.ID.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Invalid Record Lookup",
+			"Either the ID of the record or an external ID field and value must be set.",
+		)
+		return
+	}
+	if byExternalID && (state.ExternalIDField.IsNull() || state.ExternalIDValue.IsNull()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("external_id_field"),
+			"Invalid Record Lookup",
+			"Both the external ID field and its value must be set.",
+		)
+		return
+	}
+
+	var record salesforce.Record
+	var err error
+	if byExternalID {
+		record, err = d.client.GetRecordByExternalID(
+			ctx,
+			state.SObjectType.ValueString(),
+			state.ExternalIDField.ValueString(),
+			state.ExternalIDValue.ValueString(),
+			state.Fields,
+		)
+	} else {
+		record, err = d.client.GetRecord(
+			ctx,
+			state.SObjectType.ValueString(),
+			state.ID.ValueString(),
+			state.Fields,
+		)
+	}
+	if salesforce.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Salesforce Record Not Found",
+			fmt.Sprintf("No %s record matches the lookup or it is not accessible to the configured user.\n\n%s", state.SObjectType.ValueString(), err.Error()),
+		)
+		return
+	}
+	if salesforce.IsErrorCode(err, salesforce.ErrorCodeInvalidField) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("fields"),
+			"Invalid Salesforce Field",
+			err.Error(),
+		)
+		return
+	}
+	var apiErr *salesforce.APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusMultipleChoices {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("external_id_value"),
+			"Ambiguous Salesforce Record Lookup",
+			fmt.Sprintf("Several %s records have the value %q in %s.", state.SObjectType.ValueString(), state.ExternalIDValue.ValueString(), state.ExternalIDField.ValueString()),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Salesforce Record",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.Values = map[string]types.String{}
+	for field, value := range record {
+		if field == "attributes" {
+			continue
+		}
+		state.Values[field] = jsonValueString(value)
+	}
+
+	state.ID = types.StringValue(record.ID())
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
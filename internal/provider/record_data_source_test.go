@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRecordDataSource(t *testing.T) {
+	org := newMockSalesforce(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read by ID
+			{
+				Config: org.providerConfig() + `data "salesforce_record" "test" {
+					sobject_type = "Pricebook2"
+					id           = "01s69000000KXyzAAG"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.salesforce_record.test", "id", "01s69000000KXyzAAG"),
+					resource.TestCheckResourceAttr("data.salesforce_record.test", "values.Name", "Standard Price Book"),
+					resource.TestCheckResourceAttr("data.salesforce_record.test", "values.IsActive", "true"),
+					resource.TestCheckNoResourceAttr("data.salesforce_record.test", "values.Description"),
+					resource.TestCheckNoResourceAttr("data.salesforce_record.test", "values.attributes"),
+				),
+			},
+			// Read by external ID
+			{
+				Config: org.providerConfig() + `data "salesforce_record" "test" {
+					sobject_type      = "Pricebook2"
+					external_id_field = "vub_External_Id__c"
+					external_id_value = "STANDARD"
+					fields            = ["Name"]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.salesforce_record.test", "id", "01s69000000KXyzAAG"),
+					resource.TestCheckResourceAttr("data.salesforce_record.test", "values.Name", "Standard Price Book"),
+				),
+			},
+			// Either an ID or an external ID is required
+			{
+				Config: org.providerConfig() + `data "salesforce_record" "test" {
+					sobject_type = "Pricebook2"
+				}`,
+				ExpectError: regexp.MustCompile("Invalid Record Lookup"),
+			},
+		},
+	})
+}
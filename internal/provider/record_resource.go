@@ -0,0 +1,629 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/villeroy-boch/terraform-provider-salesforce/internal/salesforce"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &recordResource{}
+	_ resource.ResourceWithConfigure   = &recordResource{}
+	_ resource.ResourceWithImportState = &recordResource{}
+	_ resource.ResourceWithModifyPlan  = &recordResource{}
+)
+
+// NewRecordResource is a helper function to simplify the provider implementation.
+func NewRecordResource() resource.Resource {
+	return &recordResource{}
+}
+
+// recordResource is the resource implementation.
+type recordResource struct {
+	client *salesforce.Client
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *recordResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring Salesforce Record resource")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*salesforce.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *salesforce.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+
+	tflog.Info(ctx, "Configured Salesforce Record resource", map[string]any{"success": true})
+}
+
+// Metadata returns the resource type name.
+func (r *recordResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_record"
+}
+
+// Schema defines the schema for the resource.
+func (r *recordResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a single sObject record, e.g. a custom setting row or a lookup table entry.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the record.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sobject_type": schema.StringAttribute{
+				Description: "API name of the sObject of the record, e.g. `vub_Setting__c`. Changing it replaces the record.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"external_id_field": schema.StringAttribute{
+				Description: "API name of an external ID field to create the record by upsert. " +
+					"An existing record with the value of this field in `fields` is adopted instead of creating a duplicate. " +
+					"Changing it to another field replaces the record, adding or removing it keeps the record, e.g. after an import.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						func(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+							// The field only matters when the record is created
+							resp.RequiresReplace = !req.StateValue.IsNull() && !req.PlanValue.IsNull()
+						},
+						"Changing the external ID field to another field replaces the record.",
+						"Changing the external ID field to another field replaces the record.",
+					),
+				},
+			},
+			"fields": schema.MapAttribute{
+				ElementType: types.StringType,
+				Description: "Field values of the record by field API name. Values are converted to the type of the field, " +
+					"e.g. `\"true\"` for checkboxes or `\"2024-01-31\"` for dates. An empty string clears a field, " +
+					"fields removed from the map keep their value in Salesforce. Changing a field that can only be set on creation replaces the record.",
+				Required: true,
+			},
+		},
+	}
+}
+
+// recordResourceModel maps the resource schema data.
+type recordResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	SObjectType     types.String `tfsdk:"sobject_type"`
+	ExternalIDField types.String `tfsdk:"external_id_field"`
+	Fields          types.Map    `tfsdk:"fields"`
+}
+
+// ModifyPlan checks the planned field values against the describe metadata
+// of the sObject, so invalid fields fail the plan instead of the apply.
+func (r *recordResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the record is destroyed, or before the provider
+	// is configured, e.g. during validation with unknown provider settings
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan recordResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.SObjectType.IsUnknown() || plan.Fields.IsUnknown() || plan.ExternalIDField.IsUnknown() {
+		return
+	}
+
+	var state recordResourceModel
+	if !req.State.Raw.IsNull() {
+		diags = req.State.Get(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	creating := req.State.Raw.IsNull() || !plan.SObjectType.Equal(state.SObjectType)
+
+	description, err := r.client.GetDescription(ctx, plan.SObjectType.ValueString())
+	if salesforce.IsNotFound(err) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("sobject_type"),
+			"Salesforce Object Not Found",
+			fmt.Sprintf("The Salesforce object %q does not exist or is not accessible to the configured user.", plan.SObjectType.ValueString()),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Salesforce descriptions",
+			err.Error(),
+		)
+		return
+	}
+
+	planFields := map[string]types.String{}
+	resp.Diagnostics.Append(plan.Fields.ElementsAs(ctx, &planFields, false)...)
+	stateFields := map[string]types.String{}
+	if !creating && !state.Fields.IsNull() {
+		resp.Diagnostics.Append(state.Fields.ElementsAs(ctx, &stateFields, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if creating && !plan.ExternalIDField.IsNull() {
+		resp.Diagnostics.Append(validateExternalIDField(description, plan.ExternalIDField.ValueString(), planFields)...)
+	}
+
+	for _, name := range sortedKeys(planFields) {
+		value := planFields[name]
+		fieldPath := path.Root("fields").AtMapKey(name)
+
+		field, ok := description.Field(name)
+		if !ok {
+			resp.Diagnostics.AddAttributeError(
+				fieldPath,
+				"Salesforce Field Not Found",
+				fmt.Sprintf("The Salesforce object %q has no field %q.", description.Name, name),
+			)
+			continue
+		}
+
+		if !value.IsUnknown() {
+			_, err := field.ParseValue(value.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(
+					fieldPath,
+					"Invalid Salesforce Field Value",
+					fmt.Sprintf("The value of the %s field %q is invalid: %s.", field.Type, field.Name, err.Error()),
+				)
+				continue
+			}
+		}
+
+		if creating {
+			if !field.Createable {
+				resp.Diagnostics.AddAttributeError(
+					fieldPath,
+					"Salesforce Field Not Createable",
+					fmt.Sprintf("The field %q of %q cannot be set when creating a record.", field.Name, description.Name),
+				)
+			}
+			continue
+		}
+
+		if stateValue, ok := stateFields[name]; ok && stateValue.Equal(value) {
+			continue
+		}
+		if field.Updateable {
+			continue
+		}
+		if !field.Createable {
+			resp.Diagnostics.AddAttributeError(
+				fieldPath,
+				"Salesforce Field Not Updateable",
+				fmt.Sprintf("The field %q of %q can neither be created nor updated.", field.Name, description.Name),
+			)
+			continue
+		}
+
+		resp.RequiresReplace = append(resp.RequiresReplace, fieldPath)
+		resp.Diagnostics.AddAttributeWarning(
+			fieldPath,
+			"Salesforce Record Will Be Replaced",
+			fmt.Sprintf("The field %q of %q can only be set when creating a record, so the record is deleted and created again with a new ID.", field.Name, description.Name),
+		)
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *recordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan recordResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fields := map[string]types.String{}
+	resp.Diagnostics.Append(plan.Fields.ElementsAs(ctx, &fields, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	values, diags := r.recordValues(ctx, plan.SObjectType.ValueString(), fields)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var id string
+	var err error
+	if plan.ExternalIDField.IsNull() {
+		id, err = r.client.CreateRecord(ctx, plan.SObjectType.ValueString(), values)
+	} else {
+		id, err = r.upsert(ctx, plan.SObjectType.ValueString(), plan.ExternalIDField.ValueString(), values)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Salesforce Record",
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(id)
+
+	// Set state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *recordResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state recordResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Without fields in state, as after an import, all fields are read
+	var managed map[string]types.String
+	if !state.Fields.IsNull() {
+		managed = map[string]types.String{}
+		resp.Diagnostics.Append(state.Fields.ElementsAs(ctx, &managed, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	fields, err := r.readFields(ctx, state.SObjectType.ValueString(), state.ID.ValueString(), managed)
+	if salesforce.IsNotFound(err) {
+		tflog.Warn(ctx, "Salesforce record no longer exists, removing it from state", map[string]any{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Salesforce Record",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Fields, diags = types.MapValueFrom(ctx, types.StringType, fields)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *recordResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state recordResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planFields := map[string]types.String{}
+	resp.Diagnostics.Append(plan.Fields.ElementsAs(ctx, &planFields, false)...)
+	stateFields := map[string]types.String{}
+	resp.Diagnostics.Append(state.Fields.ElementsAs(ctx, &stateFields, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only send the fields that changed
+	changed := map[string]types.String{}
+	for name, value := range planFields {
+		if stateValue, ok := stateFields[name]; !ok || !stateValue.Equal(value) {
+			changed[name] = value
+		}
+	}
+
+	if len(changed) > 0 {
+		values, diags := r.recordValues(ctx, plan.SObjectType.ValueString(), changed)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		err := r.client.UpdateRecord(ctx, plan.SObjectType.ValueString(), state.ID.ValueString(), values)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Update Salesforce Record",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	plan.ID = state.ID
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *recordResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state recordResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteRecord(ctx, state.SObjectType.ValueString(), state.ID.ValueString())
+	if err != nil && !salesforce.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Salesforce Record",
+			err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports a record by an identifier of the form Type/Id. All
+// updateable fields with a value are imported.
+func (r *recordResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	sObjectType, id, ok := strings.Cut(req.ID, "/")
+	if !ok || sObjectType == "" || id == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import Identifier",
+			fmt.Sprintf("Expected an identifier of the form Type/Id, e.g. Account/0011t00000ABCDEAAA, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("sobject_type"), sObjectType)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("fields"), types.MapNull(types.StringType))...)
+}
+
+// upsert creates a record by the value of its external ID field in values,
+// adopting an existing record with that value.
+func (r *recordResource) upsert(ctx context.Context, sObjectType, externalIDField string, values map[string]any) (string, error) {
+	description, err := r.client.GetDescription(ctx, sObjectType)
+	if err != nil {
+		return "", err
+	}
+	field, ok := description.Field(externalIDField)
+	if !ok {
+		return "", fmt.Errorf("the Salesforce object %s has no field %s", sObjectType, externalIDField)
+	}
+
+	// The external ID is part of the URL instead of the body
+	externalID, err := field.FormatKey(values[field.Name])
+	if err != nil {
+		return "", fmt.Errorf("the external ID field %s has no valid value: %w", field.Name, err)
+	}
+	delete(values, field.Name)
+
+	id, created, err := r.client.UpsertRecord(ctx, sObjectType, field.Name, externalID, values)
+	if err != nil {
+		return "", err
+	}
+
+	tflog.Info(ctx, "Upserted Salesforce record", map[string]any{
+		"id":      id,
+		"created": created,
+	})
+
+	return id, nil
+}
+
+// validateExternalIDField checks that name is an external ID field of the
+// described object and that fields set its value.
+func validateExternalIDField(description *salesforce.Description, name string, fields map[string]types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	field, ok := description.Field(name)
+	if !ok || !(field.ExternalID || field.IDLookup) || field.Type == "id" {
+		diags.AddAttributeError(
+			path.Root("external_id_field"),
+			"Invalid External ID Field",
+			fmt.Sprintf("The Salesforce object %q has no external ID field %q.", description.Name, name),
+		)
+		return diags
+	}
+
+	for key, value := range fields {
+		if strings.EqualFold(key, field.Name) {
+			if !value.IsUnknown() && value.ValueString() == "" {
+				break
+			}
+			return diags
+		}
+	}
+
+	diags.AddAttributeError(
+		path.Root("fields"),
+		"Missing External ID Value",
+		fmt.Sprintf("The fields must set a value of the external ID field %q to upsert the record by.", field.Name),
+	)
+
+	return diags
+}
+
+// recordValues converts field values to the JSON values of the field types
+// of sObjectType.
+func (r *recordResource) recordValues(ctx context.Context, sObjectType string, fields map[string]types.String) (map[string]any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	description, err := r.client.GetDescription(ctx, sObjectType)
+	if err != nil {
+		diags.AddError(
+			"Unable to Read Salesforce descriptions",
+			err.Error(),
+		)
+		return nil, diags
+	}
+
+	values := map[string]any{}
+	for name, value := range fields {
+		field, ok := description.Field(name)
+		if !ok {
+			diags.AddAttributeError(
+				path.Root("fields").AtMapKey(name),
+				"Salesforce Field Not Found",
+				fmt.Sprintf("The Salesforce object %q has no field %q.", description.Name, name),
+			)
+			continue
+		}
+
+		values[field.Name], err = field.ParseValue(value.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("fields").AtMapKey(name),
+				"Invalid Salesforce Field Value",
+				fmt.Sprintf("The value of the %s field %q is invalid: %s.", field.Type, field.Name, err.Error()),
+			)
+		}
+	}
+
+	return values, diags
+}
+
+// readFields reads the fields of a record that are managed in state. Values
+// equal to the state, such as 1 and 1.0, keep the representation of the
+// state. Without managed fields, all updateable fields with a value are read.
+func (r *recordResource) readFields(ctx context.Context, sObjectType, id string, managed map[string]types.String) (map[string]string, error) {
+	var names []string
+	for name := range managed {
+		names = append(names, name)
+	}
+	if managed == nil {
+		description, err := r.client.GetDescription(ctx, sObjectType)
+		if err != nil {
+			return nil, err
+		}
+		for _, field := range description.Fields {
+			if field.Updateable {
+				names = append(names, field.Name)
+			}
+		}
+	}
+
+	record, err := r.client.GetRecord(ctx, sObjectType, id, names)
+	if err != nil {
+		return nil, err
+	}
+
+	// Field names of the response may differ in case from the state
+	values := map[string]json.RawMessage{}
+	for name, value := range record {
+		values[strings.ToLower(name)] = value
+	}
+
+	fields := map[string]string{}
+	for _, name := range names {
+		value := values[strings.ToLower(name)]
+		stateValue, ok := managed[name]
+		if ok && recordValueEqual(stateValue.ValueString(), value) {
+			fields[name] = stateValue.ValueString()
+			continue
+		}
+		if !ok && jsonValueString(value).IsNull() {
+			continue
+		}
+		fields[name] = jsonValueString(value).ValueString()
+	}
+
+	return fields, nil
+}
+
+// recordValueEqual reports whether a field value of the REST API equals the
+// string representation of the value in state.
+func recordValueEqual(stateValue string, raw json.RawMessage) bool {
+	decoder := json.NewDecoder(strings.NewReader(string(raw)))
+	decoder.UseNumber()
+
+	var value any
+	if len(raw) > 0 {
+		if err := decoder.Decode(&value); err != nil {
+			return false
+		}
+	}
+
+	switch value := value.(type) {
+	case nil:
+		return stateValue == ""
+	case bool:
+		parsed, err := strconv.ParseBool(stateValue)
+		return err == nil && parsed == value
+	case json.Number:
+		parsed, err := strconv.ParseFloat(stateValue, 64)
+		number, numberErr := value.Float64()
+		return err == nil && numberErr == nil && parsed == number
+	case string:
+		if value == stateValue {
+			return true
+		}
+		// Date times are returned as 2024-01-31T12:00:00.000+0000
+		returned, err := time.Parse("2006-01-02T15:04:05.000-0700", value)
+		if err != nil {
+			return false
+		}
+		parsed, err := time.Parse(time.RFC3339, stateValue)
+		return err == nil && parsed.Equal(returned)
+	}
+
+	return false
+}
+
+// sortedKeys returns the keys of m in order, for diagnostics in a stable
+// order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,407 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccRecordResource(t *testing.T) {
+	org := newMockSalesforce(t)
+
+	var id string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(*terraform.State) error {
+			if ids := org.recordIDs("vub_Setting__c"); len(ids) > 0 {
+				return fmt.Errorf("records %v were not deleted", ids)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: org.providerConfig() + `resource "salesforce_record" "test" {
+					sobject_type = "vub_Setting__c"
+					fields = {
+						Name          = "Default"
+						vub_Active__c = "true"
+						vub_Limit__c  = "10.50"
+						vub_Region__c = "EMEA"
+						vub_Key__c    = "DEFAULT"
+					}
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("salesforce_record.test", "id", regexp.MustCompile("^a0B")),
+					resource.TestCheckResourceAttrPtr("salesforce_record.test", "id", &id),
+					resource.TestCheckResourceAttr("salesforce_record.test", "fields.%", "5"),
+					// Equal values keep the configured representation
+					resource.TestCheckResourceAttr("salesforce_record.test", "fields.vub_Limit__c", "10.50"),
+					func(*terraform.State) error {
+						record := org.record("vub_Setting__c", id)
+						if record["Name"] != "Default" || record["vub_Active__c"] != true || record["vub_Limit__c"] != 10.5 {
+							return fmt.Errorf("record was not created: %v", record)
+						}
+						return nil
+					},
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName: "salesforce_record.test",
+				ImportState:  true,
+				ImportStateIdFunc: func(*terraform.State) (string, error) {
+					return "vub_Setting__c/" + id, nil
+				},
+				ImportStateVerify: true,
+				// Imports read the updateable fields as returned by Salesforce
+				ImportStateVerifyIgnore: []string{"fields.%", "fields.vub_Limit__c", "fields.vub_Region__c"},
+			},
+			// Update and Read testing
+			{
+				Config: org.providerConfig() + `resource "salesforce_record" "test" {
+					sobject_type = "vub_Setting__c"
+					fields = {
+						Name          = "Fallback"
+						vub_Active__c = "false"
+						vub_Limit__c  = "20"
+						vub_Region__c = "EMEA"
+						vub_Key__c    = "DEFAULT"
+					}
+				}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("salesforce_record.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("salesforce_record.test", "id", &id),
+					resource.TestCheckResourceAttr("salesforce_record.test", "fields.Name", "Fallback"),
+					func(*terraform.State) error {
+						record := org.record("vub_Setting__c", id)
+						if record["Name"] != "Fallback" || record["vub_Active__c"] != false || record["vub_Limit__c"] != 20.0 {
+							return fmt.Errorf("record was not updated: %v", record)
+						}
+						return nil
+					},
+				),
+			},
+			// Fields that can only be created replace the record
+			{
+				Config: org.providerConfig() + `resource "salesforce_record" "test" {
+					sobject_type = "vub_Setting__c"
+					fields = {
+						Name          = "Fallback"
+						vub_Active__c = "false"
+						vub_Limit__c  = "20"
+						vub_Region__c = "APAC"
+						vub_Key__c    = "DEFAULT"
+					}
+				}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("salesforce_record.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: func(*terraform.State) error {
+					if ids := org.recordIDs("vub_Setting__c"); len(ids) != 1 || ids[0] == id {
+						return fmt.Errorf("record %s was not replaced: %v", id, ids)
+					}
+					return nil
+				},
+			},
+			// Fields are validated at plan time
+			{
+				Config: org.providerConfig() + `resource "salesforce_record" "test" {
+					sobject_type = "vub_Setting__c"
+					fields = {
+						Name          = "Default"
+						vub_Active__c = "yes"
+					}
+				}`,
+				ExpectError: regexp.MustCompile("Invalid Salesforce Field Value"),
+			},
+			{
+				Config: org.providerConfig() + `resource "salesforce_record" "test" {
+					sobject_type = "vub_Setting__c"
+					fields = {
+						Name           = "Default"
+						vub_Missing__c = "1"
+					}
+				}`,
+				ExpectError: regexp.MustCompile("Salesforce Field Not Found"),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordResourceUpsert(t *testing.T) {
+	org := newMockSalesforce(t)
+
+	var existing string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create by upsert, adopting an existing record
+			{
+				PreConfig: func() {
+					existing = org.createRecord("vub_Setting__c", map[string]any{"Name": "Default", "vub_Key__c": "DEFAULT"})
+				},
+				Config: org.providerConfig() + `resource "salesforce_record" "adopted" {
+					sobject_type      = "vub_Setting__c"
+					external_id_field = "vub_Key__c"
+					fields = {
+						Name          = "Default"
+						vub_Active__c = "true"
+						vub_Key__c    = "DEFAULT"
+					}
+				}
+
+				resource "salesforce_record" "created" {
+					sobject_type      = "vub_Setting__c"
+					external_id_field = "vub_Key__c"
+					fields = {
+						Name       = "Other"
+						vub_Key__c = "OTHER"
+					}
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("salesforce_record.adopted", "id", &existing),
+					resource.TestCheckResourceAttr("salesforce_record.adopted", "external_id_field", "vub_Key__c"),
+					resource.TestMatchResourceAttr("salesforce_record.created", "id", regexp.MustCompile("^a0B")),
+					func(*terraform.State) error {
+						if record := org.record("vub_Setting__c", existing); record["vub_Active__c"] != true {
+							return fmt.Errorf("record was not upserted: %v", record)
+						}
+						if ids := org.recordIDs("vub_Setting__c"); len(ids) != 2 {
+							return fmt.Errorf("records = %v, want 2", ids)
+						}
+						return nil
+					},
+				),
+			},
+			// Changing the external ID field replaces the record
+			{
+				Config: org.providerConfig() + `resource "salesforce_record" "adopted" {
+					sobject_type      = "vub_Setting__c"
+					external_id_field = "Name"
+					fields = {
+						Name          = "Default"
+						vub_Active__c = "true"
+						vub_Key__c    = "DEFAULT"
+					}
+				}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("salesforce_record.adopted", plancheck.ResourceActionReplace),
+					},
+				},
+			},
+			// Removing the external ID field keeps the record
+			{
+				Config: org.providerConfig() + `resource "salesforce_record" "adopted" {
+					sobject_type = "vub_Setting__c"
+					fields = {
+						Name          = "Default"
+						vub_Active__c = "false"
+						vub_Key__c    = "DEFAULT"
+					}
+				}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("salesforce_record.adopted", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckNoResourceAttr("salesforce_record.adopted", "external_id_field"),
+			},
+			// The external ID field must be one
+			{
+				Config: org.providerConfig() + `resource "salesforce_record" "other" {
+					sobject_type      = "vub_Setting__c"
+					external_id_field = "vub_Region__c"
+					fields = {
+						vub_Region__c = "EMEA"
+					}
+				}`,
+				ExpectError: regexp.MustCompile("Invalid External ID Field"),
+			},
+		},
+	})
+}
+
+func TestRecordValueEqual(t *testing.T) {
+	tests := map[string]struct {
+		stateValue string
+		raw        string
+		want       bool
+	}{
+		"null":                 {stateValue: "", raw: "null", want: true},
+		"null and value":       {stateValue: "EMEA", raw: "null"},
+		"missing":              {stateValue: "", raw: "", want: true},
+		"boolean":              {stateValue: "true", raw: "true", want: true},
+		"boolean spelling":     {stateValue: "TRUE", raw: "true", want: true},
+		"other boolean":        {stateValue: "false", raw: "true"},
+		"invalid boolean":      {stateValue: "yes", raw: "true"},
+		"number":               {stateValue: "10.5", raw: "10.5", want: true},
+		"number trailing zero": {stateValue: "10.50", raw: "10.5", want: true},
+		"integer as decimal":   {stateValue: "20", raw: "20.0", want: true},
+		"other number":         {stateValue: "10.51", raw: "10.5"},
+		"string":               {stateValue: "EMEA", raw: `"EMEA"`, want: true},
+		"other string":         {stateValue: "emea", raw: `"EMEA"`},
+		"date time":            {stateValue: "2024-01-31T13:00:00+01:00", raw: `"2024-01-31T12:00:00.000+0000"`, want: true},
+		"other date time":      {stateValue: "2024-01-31T12:00:00+01:00", raw: `"2024-01-31T12:00:00.000+0000"`},
+		"object":               {stateValue: "{}", raw: "{}"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := recordValueEqual(test.stateValue, json.RawMessage(test.raw)); got != test.want {
+				t.Errorf("recordValueEqual(%q, %s) = %t, want %t", test.stateValue, test.raw, got, test.want)
+			}
+		})
+	}
+}
+
+func TestRecordResourceReadFields(t *testing.T) {
+	org := newMockSalesforce(t)
+	id := org.createRecord("vub_Setting__c", map[string]any{
+		"Name":          "Default",
+		"vub_Active__c": true,
+		"vub_Limit__c":  10.5,
+		"vub_Region__c": "EMEA",
+	})
+	r := &recordResource{client: org.client(t)}
+
+	tests := map[string]struct {
+		managed map[string]types.String
+		want    map[string]string
+	}{
+		"managed fields": {
+			managed: map[string]types.String{
+				"Name":          types.StringValue("Old"),
+				"vub_active__c": types.StringValue("true"),
+				"vub_Limit__c":  types.StringValue("10.50"),
+				"vub_Key__c":    types.StringValue(""),
+			},
+			want: map[string]string{
+				// Changed values are read as returned by Salesforce
+				"Name": "Default",
+				// Equal values keep the name and representation in state
+				"vub_active__c": "true",
+				"vub_Limit__c":  "10.50",
+				"vub_Key__c":    "",
+			},
+		},
+		"all updateable fields": {
+			want: map[string]string{
+				"Name":          "Default",
+				"vub_Active__c": "true",
+				"vub_Limit__c":  "10.5",
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := r.readFields(context.Background(), "vub_Setting__c", id, test.managed)
+			if err != nil {
+				t.Fatalf("readFields() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("readFields() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestRecordResourceModifyPlan(t *testing.T) {
+	r := &recordResource{client: newMockSalesforce(t).client(t)}
+
+	fields := func(fields map[string]string) map[string]tftypes.Value {
+		values := map[string]tftypes.Value{}
+		for name, value := range fields {
+			values[name] = tftypes.NewValue(tftypes.String, value)
+		}
+		return values
+	}
+	record := func(values map[string]string) map[string]any {
+		return map[string]any{
+			"id":           "a0B000000000001AAA",
+			"sobject_type": "vub_Setting__c",
+			"fields":       fields(values),
+		}
+	}
+
+	tests := map[string]struct {
+		state       map[string]any
+		plan        map[string]any
+		wantReplace path.Paths
+		wantErr     string
+		wantWarning string
+	}{
+		"create with create-only field": {
+			plan: map[string]any{
+				"sobject_type": "vub_Setting__c",
+				"fields":       fields(map[string]string{"Name": "Default", "vub_Region__c": "EMEA"}),
+			},
+		},
+		"create with read-only field": {
+			plan: map[string]any{
+				"sobject_type": "vub_Setting__c",
+				"fields":       fields(map[string]string{"Id": "a0B000000000001AAA"}),
+			},
+			wantErr: "Salesforce Field Not Createable",
+		},
+		"update of updateable field": {
+			state: record(map[string]string{"Name": "Default", "vub_Region__c": "EMEA"}),
+			plan:  record(map[string]string{"Name": "Fallback", "vub_Region__c": "EMEA"}),
+		},
+		"update of create-only field": {
+			state:       record(map[string]string{"Name": "Default", "vub_Region__c": "EMEA"}),
+			plan:        record(map[string]string{"Name": "Default", "vub_Region__c": "APAC"}),
+			wantReplace: path.Paths{path.Root("fields").AtMapKey("vub_Region__c")},
+			wantWarning: "Salesforce Record Will Be Replaced",
+		},
+		"added create-only field": {
+			state:       record(map[string]string{"Name": "Default"}),
+			plan:        record(map[string]string{"Name": "Default", "vub_Region__c": "EMEA"}),
+			wantReplace: path.Paths{path.Root("fields").AtMapKey("vub_Region__c")},
+			wantWarning: "Salesforce Record Will Be Replaced",
+		},
+		"update of read-only field": {
+			state:   record(map[string]string{"Name": "Default"}),
+			plan:    record(map[string]string{"Name": "Default", "Id": "a0B000000000002AAA"}),
+			wantErr: "Salesforce Field Not Updateable",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := modifyResourcePlan(t, r, test.state, test.plan)
+
+			if test.wantErr == "" && resp.Diagnostics.HasError() {
+				t.Fatalf("ModifyPlan() diagnostics = %v", resp.Diagnostics)
+			}
+			if test.wantErr != "" && (!resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != test.wantErr) {
+				t.Fatalf("ModifyPlan() diagnostics = %v, want %q", resp.Diagnostics, test.wantErr)
+			}
+			if test.wantWarning != "" && (resp.Diagnostics.WarningsCount() == 0 || resp.Diagnostics.Warnings()[0].Summary() != test.wantWarning) {
+				t.Errorf("ModifyPlan() diagnostics = %v, want warning %q", resp.Diagnostics, test.wantWarning)
+			}
+			if (len(resp.RequiresReplace) > 0 || len(test.wantReplace) > 0) && !reflect.DeepEqual(resp.RequiresReplace, test.wantReplace) {
+				t.Errorf("ModifyPlan() RequiresReplace = %v, want %v", resp.RequiresReplace, test.wantReplace)
+			}
+		})
+	}
+}
@@ -0,0 +1,211 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/villeroy-boch/terraform-provider-salesforce/internal/salesforce"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &sobjectsDataSource{}
+	_ datasource.DataSourceWithConfigure = &sobjectsDataSource{}
+)
+
+// NewSObjectsDataSource is a helper function to simplify the provider implementation.
+func NewSObjectsDataSource() datasource.DataSource {
+	return &sobjectsDataSource{}
+}
+
+// sobjectsDataSource is the data source implementation.
+type sobjectsDataSource struct {
+	client *salesforce.Client
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *sobjectsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring Salesforce SObjects data source")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*salesforce.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *salesforce.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+
+	tflog.Info(ctx, "Configured Salesforce SObjects data source", map[string]any{"success": true})
+}
+
+// Metadata returns the data source type name.
+func (d *sobjectsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sobjects"
+}
+
+// Schema defines the schema for the data source.
+func (d *sobjectsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Description: "Lists the sObjects available in the org.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the listed sObjects, the API version they were listed with.",
+				Computed:    true,
+			},
+			"custom_only": schema.BoolAttribute{
+				Description: "Only list custom objects.",
+				Optional:    true,
+			},
+			"queryable_only": schema.BoolAttribute{
+				Description: "Only list sObjects that can be queried.",
+				Optional:    true,
+			},
+			"name_pattern": schema.StringAttribute{
+				Description: "Regular expression the API name of listed sObjects must match, e.g. `^vub_.*__c$`.",
+				Optional:    true,
+			},
+			"sobjects": schema.ListNestedAttribute{
+				Description: "sObjects of the org.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "API name of the sObject.",
+							Computed:    true,
+						},
+						"label": schema.StringAttribute{
+							Description: "Label of the sObject.",
+							Computed:    true,
+						},
+						"label_plural": schema.StringAttribute{
+							Description: "Plural label of the sObject.",
+							Computed:    true,
+						},
+						"key_prefix": schema.StringAttribute{
+							Description: "Three character prefix of record IDs of the sObject.",
+							Computed:    true,
+						},
+						"custom": schema.BoolAttribute{
+							Description: "Whether the sObject is a custom object.",
+							Computed:    true,
+						},
+						"queryable": schema.BoolAttribute{
+							Description: "Whether the sObject can be queried.",
+							Computed:    true,
+						},
+						"createable": schema.BoolAttribute{
+							Description: "Whether records of the sObject can be created.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// sobjectsDataSourceModel maps the data source schema data.
+type sobjectsDataSourceModel struct {
+	ID            types.String    `tfsdk:"id"`
+	CustomOnly    types.Bool      `tfsdk:"custom_only"`
+	QueryableOnly types.Bool      `tfsdk:"queryable_only"`
+	NamePattern   types.String    `tfsdk:"name_pattern"`
+	SObjects      []sobjectsModel `tfsdk:"sobjects"`
+}
+
+type sobjectsModel struct {
+	Name        types.String `tfsdk:"name"`
+	Label       types.String `tfsdk:"label"`
+	LabelPlural types.String `tfsdk:"label_plural"`
+	KeyPrefix   types.String `tfsdk:"key_prefix"`
+	Custom      types.Bool   `tfsdk:"custom"`
+	Queryable   types.Bool   `tfsdk:"queryable"`
+	Createable  types.Bool   `tfsdk:"createable"`
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *sobjectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state sobjectsDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Reading Salesforce SObjects data source", map[string]any{
+		"input": fmt.Sprintf("%+v", state),
+	})
+
+	var namePattern *regexp.Regexp
+	if !state.NamePattern.IsNull() {
+		var err error
+		namePattern, err = regexp.Compile(state.NamePattern.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_pattern"),
+				"Invalid Name Pattern",
+				"The name pattern must be a valid regular expression: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	globalDescription, err := d.client.DescribeGlobal(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Salesforce sObjects",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.SObjects = []sobjectsModel{}
+	for _, sobject := range globalDescription.SObjects {
+		if state.CustomOnly.ValueBool() && !sobject.Custom {
+			continue
+		}
+		if state.QueryableOnly.ValueBool() && !sobject.Queryable {
+			continue
+		}
+		if namePattern != nil && !namePattern.MatchString(sobject.Name) {
+			continue
+		}
+
+		state.SObjects = append(state.SObjects, sobjectsModel{
+			Name:        types.StringValue(sobject.Name),
+			Label:       types.StringValue(sobject.Label),
+			LabelPlural: types.StringValue(sobject.LabelPlural),
+			KeyPrefix:   types.StringValue(sobject.KeyPrefix),
+			Custom:      types.BoolValue(sobject.Custom),
+			Queryable:   types.BoolValue(sobject.Queryable),
+			Createable:  types.BoolValue(sobject.Createable),
+		})
+	}
+
+	state.ID = types.StringValue(d.client.ApiVersion)
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSObjectsDataSource(t *testing.T) {
+	org := newMockSalesforce(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: org.providerConfig() + `data "salesforce_sobjects" "test" {
+					custom_only = true
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify only custom objects are returned
+					resource.TestCheckResourceAttr("data.salesforce_sobjects.test", "sobjects.#", "1"),
+					// Verify the first sObject to ensure all attributes are set
+					resource.TestCheckResourceAttr("data.salesforce_sobjects.test", "sobjects.0.name", "test"),
+					resource.TestCheckResourceAttr("data.salesforce_sobjects.test", "sobjects.0.label", "Training Course"),
+					resource.TestCheckResourceAttr("data.salesforce_sobjects.test", "sobjects.0.label_plural", "Training Courses"),
+					resource.TestCheckResourceAttr("data.salesforce_sobjects.test", "sobjects.0.key_prefix", "a4K"),
+					resource.TestCheckResourceAttr("data.salesforce_sobjects.test", "sobjects.0.custom", "true"),
+					resource.TestCheckResourceAttr("data.salesforce_sobjects.test", "sobjects.0.queryable", "true"),
+					resource.TestCheckResourceAttr("data.salesforce_sobjects.test", "sobjects.0.createable", "true"),
+
+					// Verify id attribute
+					resource.TestCheckResourceAttr("data.salesforce_sobjects.test", "id", "v59.0"),
+				),
+			},
+			{
+				Config: org.providerConfig() + `data "salesforce_sobjects" "test" {
+					name_pattern = "^Acc"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.salesforce_sobjects.test", "sobjects.#", "1"),
+					resource.TestCheckResourceAttr("data.salesforce_sobjects.test", "sobjects.0.name", "Account"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// validateOneOf reports an error at attributePath unless value is null,
+// unknown or one of allowed.
+func validateOneOf(diags *diag.Diagnostics, attributePath path.Path, value types.String, allowed ...string) {
+	if value.IsNull() || value.IsUnknown() {
+		return
+	}
+
+	for _, option := range allowed {
+		if value.ValueString() == option {
+			return
+		}
+	}
+
+	diags.AddAttributeError(
+		attributePath,
+		"Invalid Attribute Value",
+		fmt.Sprintf("The value must be one of %s, got: %q", quotedList(allowed), value.ValueString()),
+	)
+}
+
+// quotedList joins values as quoted, comma separated list.
+func quotedList(values []string) string {
+	quoted := make([]string, 0, len(values))
+	for _, value := range values {
+		quoted = append(quoted, fmt.Sprintf("%q", value))
+	}
+
+	return strings.Join(quoted, ", ")
+}
+
+// validateInt64Between reports an error at attributePath unless value is
+// null, unknown or between minimum and maximum inclusive.
+func validateInt64Between(diags *diag.Diagnostics, attributePath path.Path, value types.Int64, minimum, maximum int64) {
+	if value.IsNull() || value.IsUnknown() {
+		return
+	}
+
+	if value.ValueInt64() < minimum || value.ValueInt64() > maximum {
+		diags.AddAttributeError(
+			attributePath,
+			"Invalid Attribute Value",
+			fmt.Sprintf("The value must be between %d and %d, got: %d", minimum, maximum, value.ValueInt64()),
+		)
+	}
+}
+
+// markdownList joins values as comma separated list of code spans for
+// descriptions.
+func markdownList(values []string) string {
+	quoted := make([]string, 0, len(values))
+	for _, value := range values {
+		quoted = append(quoted, "`"+value+"`")
+	}
+
+	return strings.Join(quoted, ", ")
+}
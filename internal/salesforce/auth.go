@@ -0,0 +1,327 @@
+package salesforce
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Supported OAuth 2.0 grant types.
+const (
+	GrantTypePassword          = "password"
+	GrantTypeJWTBearer         = "urn:ietf:params:oauth:grant-type:jwt-bearer"
+	GrantTypeClientCredentials = "client_credentials"
+	GrantTypeRefreshToken      = "refresh_token"
+)
+
+type RespBody struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	RefreshToken string `json:"refresh_token"`
+	InstanceURL  string `json:"instance_url"`
+	ID           string `json:"id"`
+	IssuedAt     string `json:"issued_at"`
+	Signature    string `json:"signature"`
+}
+
+// verifySignature checks the signature of a token response, an HMAC-SHA256
+// of id and issued_at keyed with the client secret. Verification is skipped
+// when clientSecret is empty, as with the JWT bearer flow or a refresh token
+// of a connected app without secret, and when the response is unsigned.
+// Tokens passed in as access_token are never verified.
+func (r *RespBody) verifySignature(clientSecret string) error {
+	if clientSecret == "" || r.Signature == "" {
+		return nil
+	}
+
+	mac := hmac.New(sha256.New, []byte(clientSecret))
+	mac.Write([]byte(r.ID + r.IssuedAt))
+	expected := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+	if !hmac.Equal([]byte(expected), []byte(r.Signature)) {
+		return errors.New("signature of the token response does not match the client secret")
+	}
+
+	return nil
+}
+
+type AuthStruct struct {
+	AuthHost     string `json:"authHost"`
+	ClientID     string `json:"clientID"`
+	ClientSecret string `json:"clientSecret"`
+	GrantType    string `json:"grantType"`
+	Username     string `json:"username"`
+	Password     string `json:"password"`
+	PrivateKey   string `json:"privateKey"`
+	Audience     string `json:"audience"`
+	RefreshToken string `json:"refreshToken"`
+	// Timeout limits each request to the token endpoint, DefaultAuthTimeout
+	// if zero.
+	Timeout time.Duration `json:"timeout"`
+}
+
+// DefaultAuthTimeout is the timeout of requests to the token endpoint unless
+// AuthStruct sets a different one.
+const DefaultAuthTimeout = 5 * time.Second
+
+// Token is an access token issued for a Salesforce org.
+type Token struct {
+	AccessToken string
+	TokenType   string
+	// InstanceURL is the URL of the instance the org is on.
+	InstanceURL string
+	// ID is the identity URL of the user, which contains the org ID.
+	ID string
+	// IssuedAt is the time the token was issued, in milliseconds since the
+	// Unix epoch.
+	IssuedAt string
+}
+
+// OrgID returns the ID of the org the token was issued for, taken from the
+// identity URL, or "" if the token has none.
+func (t *Token) OrgID() string {
+	_, ids, ok := strings.Cut(t.ID, "/id/")
+	if !ok {
+		return ""
+	}
+	orgID, _, _ := strings.Cut(ids, "/")
+
+	return orgID
+}
+
+// TokenSource supplies the access tokens a Client authenticates with.
+type TokenSource interface {
+	Token(ctx context.Context) (*Token, error)
+}
+
+// NewTokenSource returns a TokenSource that requests tokens from the OAuth
+// token endpoint using the grant type of auth.
+func NewTokenSource(auth AuthStruct) (TokenSource, error) {
+	switch auth.GrantType {
+	case GrantTypePassword, GrantTypeJWTBearer, GrantTypeClientCredentials, GrantTypeRefreshToken:
+		return &oauthTokenSource{auth: auth}, nil
+	default:
+		return nil, fmt.Errorf("unsupported grant type %q", auth.GrantType)
+	}
+}
+
+// StaticTokenSource returns a TokenSource that always returns token, for
+// example one issued by `sf org display`.
+func StaticTokenSource(token *Token) TokenSource {
+	return staticTokenSource{token: token}
+}
+
+type staticTokenSource struct {
+	token *Token
+}
+
+func (s staticTokenSource) Token(_ context.Context) (*Token, error) {
+	return s.token, nil
+}
+
+// ReuseTokenSource returns a TokenSource that returns token on the first
+// call and asks next for every later one, so a pre-issued token can still be
+// renewed once it expires.
+func ReuseTokenSource(token *Token, next TokenSource) TokenSource {
+	return &reuseTokenSource{token: token, next: next}
+}
+
+type reuseTokenSource struct {
+	mu    sync.Mutex
+	token *Token
+	next  TokenSource
+}
+
+func (s *reuseTokenSource) Token(ctx context.Context) (*Token, error) {
+	s.mu.Lock()
+	token := s.token
+	s.token = nil
+	s.mu.Unlock()
+
+	if token != nil {
+		return token, nil
+	}
+	return s.next.Token(ctx)
+}
+
+// oauthTokenSource requests a new token from the OAuth token endpoint on
+// every call. When Salesforce issued a refresh token, it is tried first and
+// the configured grant type is only used again if the refresh fails.
+type oauthTokenSource struct {
+	mu           sync.Mutex
+	auth         AuthStruct
+	refreshToken string
+}
+
+func (s *oauthTokenSource) Token(ctx context.Context) (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.refreshToken != "" && s.auth.GrantType != GrantTypeRefreshToken {
+		refresh := s.auth
+		refresh.GrantType = GrantTypeRefreshToken
+		refresh.RefreshToken = s.refreshToken
+		respBody, err := getBearerToken(ctx, refresh)
+		if err == nil {
+			return s.token(respBody), nil
+		}
+		tflog.Warn(ctx, "Refreshing the Salesforce access token failed, requesting a new one with the configured grant type", map[string]any{
+			"grant_type": s.auth.GrantType,
+			"error":      err.Error(),
+		})
+		s.refreshToken = ""
+	}
+
+	respBody, err := getBearerToken(ctx, s.auth)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.token(respBody), nil
+}
+
+// token converts a token response, remembering any refresh token it carries.
+func (s *oauthTokenSource) token(respBody *RespBody) *Token {
+	if respBody.RefreshToken != "" {
+		s.refreshToken = respBody.RefreshToken
+	}
+
+	return &Token{
+		AccessToken: respBody.AccessToken,
+		TokenType:   respBody.TokenType,
+		InstanceURL: respBody.InstanceURL,
+		ID:          respBody.ID,
+		IssuedAt:    respBody.IssuedAt,
+	}
+}
+
+// tokenRequestFields returns the form fields posted to the token endpoint
+// for the grant type of auth.
+func tokenRequestFields(auth AuthStruct) ([][2]string, error) {
+	switch auth.GrantType {
+	case GrantTypePassword:
+		return [][2]string{
+			{"client_id", auth.ClientID},
+			{"client_secret", auth.ClientSecret},
+			{"grant_type", auth.GrantType},
+			{"username", auth.Username},
+			{"password", auth.Password},
+		}, nil
+	case GrantTypeJWTBearer:
+		assertion, err := newJWTAssertion(auth)
+		if err != nil {
+			return nil, err
+		}
+		return [][2]string{
+			{"grant_type", auth.GrantType},
+			{"assertion", assertion},
+		}, nil
+	case GrantTypeClientCredentials:
+		return [][2]string{
+			{"client_id", auth.ClientID},
+			{"client_secret", auth.ClientSecret},
+			{"grant_type", auth.GrantType},
+		}, nil
+	case GrantTypeRefreshToken:
+		fields := [][2]string{
+			{"client_id", auth.ClientID},
+			{"grant_type", auth.GrantType},
+			{"refresh_token", auth.RefreshToken},
+		}
+		// The secret is optional for connected apps that do not require it
+		// on refresh.
+		if auth.ClientSecret != "" {
+			fields = append(fields, [2]string{"client_secret", auth.ClientSecret})
+		}
+		return fields, nil
+	default:
+		return nil, fmt.Errorf("unsupported grant type %q", auth.GrantType)
+	}
+}
+
+func getBearerToken(ctx context.Context, auth AuthStruct) (*RespBody, error) {
+
+	fields, err := tokenRequestFields(auth)
+	if err != nil {
+		return nil, err
+	}
+
+	reqBody := &bytes.Buffer{}
+	writer := multipart.NewWriter(reqBody)
+
+	for _, field := range fields {
+		fw, err := writer.CreateFormField(field[0])
+		if err != nil {
+			return nil, err
+		}
+		_, err = io.Copy(fw, strings.NewReader(field[1]))
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = writer.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"POST",
+		auth.AuthHost,
+		bytes.NewReader(reqBody.Bytes()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	timeout := auth.Timeout
+	if timeout == 0 {
+		timeout = DefaultAuthTimeout
+	}
+	d := http.Client{Timeout: timeout}
+	resp, err := d.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func(Body io.ReadCloser) {
+		Body.Close()
+	}(resp.Body)
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAuthError(resp.StatusCode, body)
+	}
+
+	respBody := &RespBody{}
+	err = json.Unmarshal(body, respBody)
+	if err != nil {
+		return nil, fmt.Errorf("decoding token response: %w", err)
+	}
+	if respBody.AccessToken == "" {
+		return nil, errors.New("token response does not contain an access token")
+	}
+
+	err = respBody.verifySignature(auth.ClientSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	return respBody, nil
+}
@@ -0,0 +1,146 @@
+package salesforce
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// newTokenServer returns the URL of a token endpoint issuing tokens named
+// after the grant type and rejecting the grant types in reject. The grant
+// types of all requests are appended to grants.
+func newTokenServer(t *testing.T, grants *[]string, reject ...string) string {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		err := r.ParseMultipartForm(1 << 20)
+		if err != nil {
+			t.Errorf("parsing token request: %v", err)
+		}
+		grantType := r.FormValue("grant_type")
+		*grants = append(*grants, grantType)
+
+		for _, rejected := range reject {
+			if grantType == rejected {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"error":"invalid_grant","error_description":"expired access/refresh token"}`))
+				return
+			}
+		}
+		_ = json.NewEncoder(w).Encode(RespBody{
+			AccessToken:  grantType + "-token",
+			RefreshToken: "refresh-" + grantType,
+			InstanceURL:  "https://example.my.salesforce.com",
+		})
+	}))
+	t.Cleanup(server.Close)
+
+	return server.URL
+}
+
+func TestOAuthTokenSourceUsesRefreshToken(t *testing.T) {
+	var grants []string
+	tokenSource, err := NewTokenSource(AuthStruct{
+		AuthHost:  newTokenServer(t, &grants),
+		GrantType: GrantTypePassword,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{"password-token", "refresh_token-token"} {
+		token, err := tokenSource.Token(context.Background())
+		if err != nil {
+			t.Fatalf("Token() error = %v", err)
+		}
+		if token.AccessToken != want {
+			t.Errorf("AccessToken = %q, want %q", token.AccessToken, want)
+		}
+	}
+	if len(grants) != 2 || grants[1] != GrantTypeRefreshToken {
+		t.Errorf("grant types = %v", grants)
+	}
+}
+
+func TestOAuthTokenSourceFallsBackAfterRefreshFailure(t *testing.T) {
+	var grants []string
+	tokenSource, err := NewTokenSource(AuthStruct{
+		AuthHost:  newTokenServer(t, &grants, GrantTypeRefreshToken),
+		GrantType: GrantTypePassword,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 2; i++ {
+		token, err := tokenSource.Token(context.Background())
+		if err != nil {
+			t.Fatalf("Token() error = %v", err)
+		}
+		if token.AccessToken != "password-token" {
+			t.Errorf("AccessToken = %q, want password-token", token.AccessToken)
+		}
+	}
+
+	want := []string{GrantTypePassword, GrantTypeRefreshToken, GrantTypePassword}
+	if len(grants) != len(want) {
+		t.Fatalf("grant types = %v, want %v", grants, want)
+	}
+	for i := range want {
+		if grants[i] != want[i] {
+			t.Errorf("grant types = %v, want %v", grants, want)
+		}
+	}
+}
+
+func TestVerifySignature(t *testing.T) {
+	// Signature of id and issued_at below keyed with "secret".
+	signed := RespBody{
+		ID:        "https://login.salesforce.com/id/00Dxx0000001gPL/005xx000001Sv6e",
+		IssuedAt:  "1700000000000",
+		Signature: "1lAcK2D/5nzToBcltM4Ll+mVdTWY3kT5W58FqySZRMU=",
+	}
+
+	tests := map[string]struct {
+		body         RespBody
+		clientSecret string
+		wantErr      bool
+	}{
+		"valid": {
+			body:         signed,
+			clientSecret: "secret",
+		},
+		"wrong secret": {
+			body:         signed,
+			clientSecret: "other",
+			wantErr:      true,
+		},
+		"tampered id": {
+			body: RespBody{
+				ID:        signed.ID + "x",
+				IssuedAt:  signed.IssuedAt,
+				Signature: signed.Signature,
+			},
+			clientSecret: "secret",
+			wantErr:      true,
+		},
+		"no client secret": {
+			body: signed,
+		},
+		"unsigned": {
+			body:         RespBody{ID: signed.ID, IssuedAt: signed.IssuedAt},
+			clientSecret: "secret",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := test.body.verifySignature(test.clientSecret)
+			if (err != nil) != test.wantErr {
+				t.Errorf("verifySignature() error = %v, wantErr %t", err, test.wantErr)
+			}
+		})
+	}
+}
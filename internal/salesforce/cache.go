@@ -0,0 +1,177 @@
+package salesforce
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// DescribeCache stores describe results on disk, keyed by org ID, API version
+// and object. Stored results are revalidated with If-Modified-Since, so an
+// unchanged object costs a 304 response instead of the full payload.
+type DescribeCache struct {
+	// Dir is the directory the results are stored in.
+	Dir string
+	// MaxAge is how long a stored result is used without revalidating it.
+	// Zero revalidates every result.
+	MaxAge time.Duration
+}
+
+// describeCacheEntry is the content of a cache file.
+type describeCacheEntry struct {
+	LastModified string          `json:"lastModified"`
+	StoredAt     time.Time       `json:"storedAt"`
+	Description  json.RawMessage `json:"description"`
+}
+
+// fresh reports whether the entry may be used without revalidating it.
+func (e *describeCacheEntry) fresh(maxAge time.Duration) bool {
+	return maxAge > 0 && time.Since(e.StoredAt) < maxAge
+}
+
+// path returns the file the result for key is stored in.
+func (dc *DescribeCache) path(key describeCacheKey) string {
+	return filepath.Join(
+		dc.Dir,
+		url.PathEscape(key.orgID),
+		url.PathEscape(key.apiVersion),
+		url.PathEscape(strings.ToLower(key.sfObject))+".json",
+	)
+}
+
+// load returns the stored result for key, or nil if there is none. A nil
+// cache has no results.
+func (dc *DescribeCache) load(ctx context.Context, key describeCacheKey) *describeCacheEntry {
+	if dc == nil {
+		return nil
+	}
+
+	content, err := os.ReadFile(dc.path(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		tflog.Warn(ctx, "Unable to read Salesforce describe cache", map[string]any{"error": err.Error()})
+		return nil
+	}
+
+	entry := &describeCacheEntry{}
+	err = json.Unmarshal(content, entry)
+	if err != nil || len(entry.Description) == 0 {
+		tflog.Warn(ctx, "Ignoring invalid Salesforce describe cache file", map[string]any{"path": dc.path(key)})
+		return nil
+	}
+
+	return entry
+}
+
+// store saves description as the result for key. The cache is best effort,
+// so failures are logged instead of failing the read.
+func (dc *DescribeCache) store(ctx context.Context, key describeCacheKey, lastModified string, description []byte) {
+	if dc == nil {
+		return
+	}
+
+	err := dc.write(key, describeCacheEntry{
+		LastModified: lastModified,
+		StoredAt:     time.Now(),
+		Description:  description,
+	})
+	if err != nil {
+		tflog.Warn(ctx, "Unable to write Salesforce describe cache", map[string]any{"error": err.Error()})
+	}
+}
+
+// remove deletes the stored result for key.
+func (dc *DescribeCache) remove(ctx context.Context, key describeCacheKey) {
+	if dc == nil {
+		return
+	}
+
+	err := os.Remove(dc.path(key))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		tflog.Warn(ctx, "Unable to remove Salesforce describe cache file", map[string]any{"error": err.Error()})
+	}
+}
+
+// write replaces the cache file of key through a rename, so concurrent
+// readers never see a partial file.
+func (dc *DescribeCache) write(key describeCacheKey, entry describeCacheEntry) error {
+	content, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	path := dc.path(key)
+	err = os.MkdirAll(filepath.Dir(path), 0o700)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".describe-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = tmp.Write(content)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+// describeCacheKey identifies a describe result.
+type describeCacheKey struct {
+	orgID      string
+	apiVersion string
+	sfObject   string
+}
+
+// describeCacheKey returns the cache key of the description of sfObject in
+// the org of the current token.
+func (c *Client) describeCacheKey(sfObject string) describeCacheKey {
+	orgID := ""
+	if token := c.currentToken(); token != nil {
+		orgID = token.OrgID()
+	}
+	if orgID == "" {
+		// Tokens without identity URL, such as pre-issued access tokens,
+		// are told apart by their instance.
+		if instance, err := url.Parse(c.instanceURL()); err == nil {
+			orgID = instance.Host
+		}
+	}
+
+	return describeCacheKey{
+		orgID:      orgID,
+		apiVersion: c.ApiVersion,
+		sfObject:   sfObject,
+	}
+}
+
+// lastModified returns the time a describe response was last modified, to
+// be sent as If-Modified-Since when revalidating it.
+func lastModified(header http.Header) string {
+	if value := header.Get("Last-Modified"); value != "" {
+		return value
+	}
+	if value := header.Get("Date"); value != "" {
+		return value
+	}
+
+	return time.Now().UTC().Format(http.TimeFormat)
+}
@@ -0,0 +1,190 @@
+package salesforce
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestClientDescribeCacheKey(t *testing.T) {
+	tests := map[string]struct {
+		client *Client
+		want   describeCacheKey
+	}{
+		"identity url": {
+			client: &Client{
+				ApiVersion: "v59.0",
+				token: &Token{
+					ID:          "https://login.salesforce.com/id/00Dxx0000001gPL/005xx000001Sv6e",
+					InstanceURL: "https://example.my.salesforce.com",
+				},
+			},
+			want: describeCacheKey{orgID: "00Dxx0000001gPL", apiVersion: "v59.0", sfObject: "Account"},
+		},
+		"instance": {
+			client: &Client{
+				ApiVersion: "v59.0",
+				token:      &Token{InstanceURL: "https://example.my.salesforce.com"},
+			},
+			want: describeCacheKey{orgID: "example.my.salesforce.com", apiVersion: "v59.0", sfObject: "Account"},
+		},
+		"api host": {
+			client: &Client{
+				HostURL:    "https://api.example.com",
+				ApiVersion: "v58.0",
+			},
+			want: describeCacheKey{orgID: "api.example.com", apiVersion: "v58.0", sfObject: "Account"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := test.client.describeCacheKey("Account"); got != test.want {
+				t.Errorf("describeCacheKey() = %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestDescribeCachePath(t *testing.T) {
+	cache := &DescribeCache{Dir: "cache"}
+
+	got := cache.path(describeCacheKey{orgID: "00D/x", apiVersion: "v59.0", sfObject: "My_Object__c"})
+	want := filepath.Join("cache", "00D%2Fx", "v59.0", "my_object__c.json")
+	if got != want {
+		t.Errorf("path() = %q, want %q", got, want)
+	}
+}
+
+func TestDescribeCacheStore(t *testing.T) {
+	ctx := context.Background()
+	cache := &DescribeCache{Dir: t.TempDir(), MaxAge: time.Hour}
+	key := describeCacheKey{orgID: "00Dxx0000001gPL", apiVersion: "v59.0", sfObject: "Account"}
+
+	if entry := cache.load(ctx, key); entry != nil {
+		t.Fatalf("load() = %+v before anything was stored", entry)
+	}
+
+	cache.store(ctx, key, "Wed, 21 Oct 2015 07:28:00 GMT", []byte(`{"name":"Account"}`))
+	cache.store(ctx, key, "Thu, 22 Oct 2015 07:28:00 GMT", []byte(`{"name":"Account","label":"Account"}`))
+
+	entry := cache.load(ctx, key)
+	if entry == nil {
+		t.Fatal("load() = nil after store")
+	}
+	if entry.LastModified != "Thu, 22 Oct 2015 07:28:00 GMT" || string(entry.Description) != `{"name":"Account","label":"Account"}` {
+		t.Errorf("load() = %+v, want the latest result", entry)
+	}
+	if !entry.fresh(cache.MaxAge) || entry.fresh(0) {
+		t.Error("fresh() does not respect the maximum age")
+	}
+
+	// Results are written through a temporary file that is renamed, so no
+	// temporary files are left behind.
+	files, err := os.ReadDir(filepath.Dir(cache.path(key)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 || files[0].Name() != "account.json" {
+		t.Errorf("cache directory contains %v, want only account.json", files)
+	}
+
+	err = os.WriteFile(cache.path(key), []byte("{"), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if entry := cache.load(ctx, key); entry != nil {
+		t.Errorf("load() = %+v for a corrupt file", entry)
+	}
+
+	cache.remove(ctx, key)
+	cache.remove(ctx, key)
+	if _, err := os.Stat(cache.path(key)); !os.IsNotExist(err) {
+		t.Errorf("cache file still exists after remove: %v", err)
+	}
+}
+
+func TestLastModified(t *testing.T) {
+	const date = "Wed, 21 Oct 2015 07:28:00 GMT"
+
+	if got := lastModified(http.Header{"Last-Modified": []string{date}, "Date": []string{"other"}}); got != date {
+		t.Errorf("lastModified() = %q, want Last-Modified", got)
+	}
+	if got := lastModified(http.Header{"Date": []string{date}}); got != date {
+		t.Errorf("lastModified() = %q, want Date", got)
+	}
+	if _, err := http.ParseTime(lastModified(http.Header{})); err != nil {
+		t.Errorf("lastModified() without headers is not an HTTP date: %v", err)
+	}
+}
+
+func TestClientGetDescriptionRevalidates(t *testing.T) {
+	const modified = "Wed, 21 Oct 2015 07:28:00 GMT"
+
+	var requests atomic.Int32
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.Header.Get("If-Modified-Since") == modified {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Last-Modified", modified)
+		_, _ = w.Write([]byte(`{"name":"Account"}`))
+	}), nil, nil)
+	client.DescribeCache = &DescribeCache{Dir: t.TempDir()}
+
+	for i := 0; i < 2; i++ {
+		description, err := client.GetDescription(context.Background(), "Account")
+		if err != nil {
+			t.Fatalf("GetDescription() error = %v", err)
+		}
+		if description.Name != "Account" {
+			t.Errorf("Name = %q, want Account", description.Name)
+		}
+	}
+	if got := requests.Load(); got != 2 {
+		t.Errorf("sent %d requests, want 2", got)
+	}
+
+	entry := client.DescribeCache.load(context.Background(), client.describeCacheKey("Account"))
+	if entry == nil || entry.LastModified != modified {
+		t.Errorf("cache entry = %+v, want Last-Modified %q", entry, modified)
+	}
+}
+
+func TestClientGetDescriptionsStoresLastModified(t *testing.T) {
+	const modified = "Wed, 21 Oct 2015 07:28:00 GMT"
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/composite/batch") {
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+		w.Header().Set("Last-Modified", modified)
+		_ = json.NewEncoder(w).Encode(batchResponse{Results: []BatchResult{
+			{StatusCode: http.StatusOK, Result: json.RawMessage(`{"name":"Account"}`)},
+			{StatusCode: http.StatusOK, Result: json.RawMessage(`{"name":"Contact"}`)},
+		}})
+	}), nil, nil)
+	client.DescribeCache = &DescribeCache{Dir: t.TempDir()}
+
+	descriptions, err := client.GetDescriptions(context.Background(), []string{"Account", "Contact"})
+	if err != nil {
+		t.Fatalf("GetDescriptions() error = %v", err)
+	}
+	if len(descriptions) != 2 || descriptions[0].Name != "Account" || descriptions[1].Name != "Contact" {
+		t.Fatalf("GetDescriptions() = %+v", descriptions)
+	}
+
+	for _, sfObject := range []string{"Account", "Contact"} {
+		entry := client.DescribeCache.load(context.Background(), client.describeCacheKey(sfObject))
+		if entry == nil || entry.LastModified != modified {
+			t.Errorf("cache entry of %s = %+v, want Last-Modified %q", sfObject, entry, modified)
+		}
+	}
+}
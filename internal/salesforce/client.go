@@ -2,60 +2,64 @@ package salesforce
 
 import (
 	"bytes"
-	"encoding/json"
+	"context"
 	"fmt"
 	"io"
-	"mime/multipart"
 	"net/http"
-	"strings"
+	"sync"
 	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 type Client struct {
-	HostURL    string
-	ApiVersion string
-	HTTPClient *http.Client
-	Auth       AuthStruct
-}
+	// HostURL overrides the instance URL of the access token when set.
+	HostURL     string
+	ApiVersion  string
+	HTTPClient  *http.Client
+	TokenSource TokenSource
+	RetryPolicy RetryPolicy
+	UsageLimit  APIUsageLimit
 
-type RespBody struct {
-	AccessToken string `json:"access_token"`
-	TokenType   string `json:"token_type"`
-}
+	// DescribeCache stores describe results on disk when set.
+	DescribeCache *DescribeCache
 
-type AuthStruct struct {
-	AuthHost     string `json:"authHost"`
-	ClientID     string `json:"clientID"`
-	ClientSecret string `json:"clientSecret"`
-	GrantType    string `json:"grantType"`
-	Username     string `json:"username"`
-	Password     string `json:"password"`
-	BearerToken  string `json:"bearerToken"`
+	// MetadataPollInterval is the first wait between status checks of
+	// deployments and retrievals.
+	MetadataPollInterval time.Duration
+
+	// mu guards token, which is replaced when the session expires, refresh,
+	// the renewal in progress, and apiUsage, which is updated with every
+	// response.
+	mu       sync.Mutex
+	token    *Token
+	refresh  *refreshCall
+	apiUsage APIUsage
 }
 
-func NewClient(apiHost, apiVersion, authHost, clientID, clientSecret, grantType, username, password *string) (*Client, error) {
+// DefaultRequestTimeout is the timeout of each API request unless the
+// HTTPClient of the Client sets a different one.
+const DefaultRequestTimeout = 10 * time.Second
+
+func NewClient(ctx context.Context, apiHost, apiVersion *string, tokenSource TokenSource) (*Client, error) {
 
 	c := Client{
-		HTTPClient: &http.Client{Timeout: 10 * time.Second},
-		HostURL:    *apiHost,
-		ApiVersion: *apiVersion,
+		HTTPClient:  &http.Client{Timeout: DefaultRequestTimeout},
+		HostURL:     *apiHost,
+		ApiVersion:  *apiVersion,
+		TokenSource: tokenSource,
+		RetryPolicy: DefaultRetryPolicy(),
+
+		MetadataPollInterval: DefaultMetadataPollInterval,
 	}
 
-	// If username or password not provided, return empty client
-	if authHost == nil || clientID == nil || clientSecret == nil || grantType == nil || username == nil || password == nil {
+	// If no token source provided, return empty client
+	if tokenSource == nil {
 		return &c, nil
 	}
 
-	c.Auth = AuthStruct{
-		AuthHost:     *authHost,
-		ClientID:     *clientID,
-		ClientSecret: *clientSecret,
-		GrantType:    *grantType,
-		Username:     *username,
-		Password:     *password,
-	}
 	var err error
-	c.Auth.BearerToken, err = getBearerToken(c.Auth)
+	c.token, err = tokenSource.Token(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -63,100 +67,237 @@ func NewClient(apiHost, apiVersion, authHost, clientID, clientSecret, grantType,
 	return &c, nil
 }
 
-func getBearerToken(auth AuthStruct) (string, error) {
+// currentToken returns the token requests are currently sent with.
+func (c *Client) currentToken() *Token {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 
-	reqBody := &bytes.Buffer{}
-	writer := multipart.NewWriter(reqBody)
+	return c.token
+}
 
-	fw, err := writer.CreateFormField("client_id")
-	if err != nil {
-		return "", err
+// instanceURL returns the base URL of REST requests. Without a configured
+// API host, it is the instance the current token was issued for, so
+// requests follow the org when My Domain changes.
+func (c *Client) instanceURL() string {
+	if c.HostURL != "" {
+		return c.HostURL
 	}
-	_, err = io.Copy(fw, strings.NewReader(auth.ClientID))
-	if err != nil {
-		return "", err
-	}
-	fw, err = writer.CreateFormField("client_secret")
-	if err != nil {
-		return "", err
+	if token := c.currentToken(); token != nil {
+		return token.InstanceURL
 	}
-	_, err = io.Copy(fw, strings.NewReader(auth.ClientSecret))
-	if err != nil {
-		return "", err
+	return ""
+}
+
+// APIUsage returns the API usage reported with the latest response.
+func (c *Client) APIUsage() APIUsage {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.apiUsage
+}
+
+// recordAPIUsage stores and logs the API usage reported in header.
+func (c *Client) recordAPIUsage(ctx context.Context, header http.Header) {
+	usage, ok := parseLimitInfo(header)
+	if !ok {
+		return
 	}
-	fw, err = writer.CreateFormField("grant_type")
-	if err != nil {
-		return "", err
+
+	c.mu.Lock()
+	c.apiUsage = usage
+	c.mu.Unlock()
+
+	fields := map[string]any{
+		"api_usage_used":    usage.Used,
+		"api_usage_limit":   usage.Limit,
+		"api_usage_percent": usage.Percent(),
 	}
-	_, err = io.Copy(fw, strings.NewReader(auth.GrantType))
-	if err != nil {
-		return "", err
+	if c.UsageLimit.exceeded(usage) {
+		tflog.Warn(ctx, "Salesforce API usage exceeds the configured threshold", fields)
+		return
 	}
-	fw, err = writer.CreateFormField("username")
-	if err != nil {
-		return "", err
+	tflog.Debug(ctx, "Salesforce API usage", fields)
+}
+
+// checkAPIUsage enforces the usage limit before a request is sent.
+func (c *Client) checkAPIUsage(ctx context.Context) error {
+	usage := c.APIUsage()
+	if !c.UsageLimit.exceeded(usage) {
+		return nil
 	}
-	_, err = io.Copy(fw, strings.NewReader(auth.Username))
-	if err != nil {
-		return "", err
+
+	if c.UsageLimit.Action == APIUsageActionStop {
+		return &APIUsageLimitError{Usage: usage, Threshold: c.UsageLimit.Threshold}
 	}
-	fw, err = writer.CreateFormField("password")
-	if err != nil {
-		return "", err
+
+	return sleep(ctx, c.UsageLimit.Delay)
+}
+
+// refreshToken replaces stale with a new token from the token source. When
+// several requests see the same expired session, only the first one asks the
+// token source and the others wait for its result. The token source is asked
+// without holding mu, so other requests are not blocked by a slow token
+// endpoint.
+func (c *Client) refreshToken(ctx context.Context, stale *Token) error {
+	c.mu.Lock()
+	if c.token != stale {
+		c.mu.Unlock()
+		return nil
 	}
-	_, err = io.Copy(fw, strings.NewReader(auth.Password))
-	if err != nil {
-		return "", err
+	if call := c.refresh; call != nil {
+		c.mu.Unlock()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-call.done:
+			return call.err
+		}
 	}
-	err = writer.Close()
-	if err != nil {
-		return "", err
+	call := &refreshCall{done: make(chan struct{})}
+	c.refresh = call
+	c.mu.Unlock()
+
+	token, err := c.TokenSource.Token(ctx)
+
+	c.mu.Lock()
+	if err == nil {
+		c.token = token
+	} else {
+		call.err = fmt.Errorf("refreshing access token: %w", err)
 	}
+	c.refresh = nil
+	c.mu.Unlock()
+	close(call.done)
 
-	req, err := http.NewRequest(
-		"POST",
-		auth.AuthHost,
-		bytes.NewReader(reqBody.Bytes()),
-	)
-	if err != nil {
-		return "", err
+	return call.err
+}
+
+// refreshCall is a token refresh in progress, which requests with the same
+// expired session wait for.
+type refreshCall struct {
+	done chan struct{}
+	err  error
+}
+
+// sessionExpiredError converts a response rejecting a token that cannot be
+// renewed into an AuthError.
+func sessionExpiredError(statusCode int, body []byte) *AuthError {
+	apiErr := newAPIError(statusCode, body)
+
+	return &AuthError{
+		StatusCode:  statusCode,
+		ErrorCode:   apiErr.Errors[0].ErrorCode,
+		Description: "the access token expired or is invalid and cannot be renewed: " + apiErr.Errors[0].Message,
 	}
+}
 
-	req.Header.Set("Content-Type", writer.FormDataContentType())
-	d := http.Client{Timeout: time.Duration(5) * time.Second}
-	resp, err := d.Do(req)
+// doRequest sends req and returns the body of a successful response. The
+// context of req cancels the request, including waits between retries.
+func (c *Client) doRequest(req *http.Request) ([]byte, error) {
+	res, body, err := c.do(req)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-	defer func(Body io.ReadCloser) {
-		Body.Close()
-	}(resp.Body)
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		fmt.Printf("Fehler 3: %s", err)
+	if res.StatusCode != http.StatusOK {
+		return nil, newAPIError(res.StatusCode, body)
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		fmt.Printf("status: %d, body: %s", resp.StatusCode, body)
+	return body, nil
+}
+
+// do sends req like doRequest, but returns the final response with any
+// status code so callers can handle statuses other than 200 themselves.
+func (c *Client) do(req *http.Request) (*http.Response, []byte, error) {
+	ctx := req.Context()
+
+	// Buffer the request body so the request can be sent again after the
+	// access token was refreshed or a transient failure.
+	if req.Body != nil && req.GetBody == nil {
+		payload, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, nil, err
+		}
+		req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(payload))
+		req.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(payload)), nil
+		}
 	}
 
-	respBody := &RespBody{}
-	err = json.Unmarshal(body, respBody)
-	if err != nil {
-		fmt.Printf("Fehler 3: %s", err)
+	refreshed := false
+	for attempt, retries := 0, 0; ; attempt++ {
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, nil, err
+			}
+			req.Body = body
+		}
+
+		err := c.checkAPIUsage(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		token := c.currentToken()
+		res, body, err := c.send(req, token)
+		if err != nil {
+			if retries < c.RetryPolicy.MaxRetries && isIdempotent(req.Method) && ctx.Err() == nil {
+				err = sleep(ctx, c.RetryPolicy.backoff(retries, nil))
+				if err != nil {
+					return nil, nil, err
+				}
+				retries++
+				continue
+			}
+			return nil, nil, err
+		}
+		c.recordAPIUsage(ctx, res.Header)
+
+		// Re-authenticate once when the session expired and resend the
+		// request. A static token would only be sent again unchanged.
+		if !refreshed && isSessionExpired(res.StatusCode, body) && c.TokenSource != nil {
+			if _, static := c.TokenSource.(staticTokenSource); static {
+				return nil, nil, sessionExpiredError(res.StatusCode, body)
+			}
+			err = c.refreshToken(ctx, token)
+			if err != nil {
+				return nil, nil, err
+			}
+			refreshed = true
+			continue
+		}
+
+		if retries < c.RetryPolicy.MaxRetries && c.RetryPolicy.shouldRetry(req.Method, res.StatusCode, body) {
+			tflog.Debug(ctx, "Retrying Salesforce request", map[string]any{
+				"status_code": res.StatusCode,
+				"retry":       retries + 1,
+			})
+			err = sleep(ctx, c.RetryPolicy.backoff(retries, res.Header))
+			if err != nil {
+				return nil, nil, err
+			}
+			retries++
+			continue
+		}
+
+		return res, body, nil
 	}
-	return respBody.AccessToken, nil
 }
 
-func (c *Client) doRequest(req *http.Request) ([]byte, error) {
+// send sends req authorized with token and returns the response together
+// with its body, which is already read and closed.
+func (c *Client) send(req *http.Request, token *Token) (*http.Response, []byte, error) {
 	// Note: this will have problems if there are redirects
 	// see https://stackoverflow.com/a/31309385
-	req.Header.Set("Authorization", "Bearer "+c.Auth.BearerToken)
+	if token != nil {
+		req.Header.Set("Authorization", "Bearer "+token.AccessToken)
+	}
 
 	res, err := c.HTTPClient.Do(req)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer func(Body io.ReadCloser) {
 		Body.Close()
@@ -164,13 +305,29 @@ func (c *Client) doRequest(req *http.Request) ([]byte, error) {
 
 	body, err := io.ReadAll(res.Body)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("status: %d, body: %s", res.StatusCode, body)
+	return res, body, nil
+}
+
+// isSessionExpired reports whether a response rejected the access token.
+func isSessionExpired(statusCode int, body []byte) bool {
+	if statusCode == http.StatusUnauthorized {
+		return true
 	}
+	return statusCode >= http.StatusBadRequest && bytes.Contains(body, []byte("INVALID_SESSION_ID"))
+}
 
-	return body, err
+// sleep waits for d or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
 
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
 }
@@ -0,0 +1,154 @@
+package salesforce
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newTestClient returns a client sending its requests to handler with
+// token, renewed by tokenSource.
+func newTestClient(t *testing.T, handler http.Handler, token *Token, tokenSource TokenSource) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return &Client{
+		HostURL:     server.URL,
+		ApiVersion:  "v59.0",
+		HTTPClient:  server.Client(),
+		TokenSource: tokenSource,
+		RetryPolicy: RetryPolicy{
+			MaxRetries: 2,
+			MinBackoff: time.Millisecond,
+			MaxBackoff: time.Millisecond,
+			ErrorCodes: DefaultRetryPolicy().ErrorCodes,
+		},
+		token: token,
+
+		MetadataPollInterval: time.Millisecond,
+	}
+}
+
+// tokenSourceFunc adapts a function to a TokenSource.
+type tokenSourceFunc func(ctx context.Context) (*Token, error)
+
+func (f tokenSourceFunc) Token(ctx context.Context) (*Token, error) {
+	return f(ctx)
+}
+
+func TestClientRefreshesExpiredToken(t *testing.T) {
+	var calls atomic.Int32
+	var client *Client
+	tokenSource := tokenSourceFunc(func(ctx context.Context) (*Token, error) {
+		calls.Add(1)
+		// The token is renewed without holding the client lock, so the
+		// client stays usable while the token endpoint responds.
+		_ = client.APIUsage()
+		time.Sleep(10 * time.Millisecond)
+		return &Token{AccessToken: "fresh"}, nil
+	})
+	client = newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer fresh" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`[{"message":"Session expired or invalid","errorCode":"INVALID_SESSION_ID"}]`))
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}), &Token{AccessToken: "stale"}, tokenSource)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 5)
+	for i := 0; i < cap(errs); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, client.HostURL+"/services/data/v59.0/", nil)
+			if err == nil {
+				_, err = client.doRequest(req)
+			}
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Errorf("doRequest() error = %v", err)
+		}
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("token source called %d times, want 1", got)
+	}
+	if got := client.currentToken().AccessToken; got != "fresh" {
+		t.Errorf("token = %q, want fresh", got)
+	}
+}
+
+func TestClientRefreshFailure(t *testing.T) {
+	tokenSource := tokenSourceFunc(func(ctx context.Context) (*Token, error) {
+		return nil, &AuthError{StatusCode: http.StatusBadRequest, ErrorCode: AuthErrorInvalidGrant}
+	})
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}), &Token{AccessToken: "stale"}, tokenSource)
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, client.HostURL+"/services/data/v59.0/", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.doRequest(req)
+
+	var authErr *AuthError
+	if !errors.As(err, &authErr) || authErr.ErrorCode != AuthErrorInvalidGrant {
+		t.Fatalf("doRequest() error = %v, want invalid_grant AuthError", err)
+	}
+}
+
+func TestClientStaticTokenExpired(t *testing.T) {
+	var requests atomic.Int32
+	token := &Token{AccessToken: "static"}
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`[{"message":"Session expired or invalid","errorCode":"INVALID_SESSION_ID"}]`))
+	}), token, StaticTokenSource(token))
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, client.HostURL+"/services/data/v59.0/", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.doRequest(req)
+
+	var authErr *AuthError
+	if !errors.As(err, &authErr) {
+		t.Fatalf("doRequest() error = %v, want AuthError", err)
+	}
+	if authErr.StatusCode != http.StatusUnauthorized || authErr.ErrorCode != ErrorCodeInvalidSessionID {
+		t.Errorf("AuthError = %+v", authErr)
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("sent %d requests, want 1", got)
+	}
+}
+
+func TestClientInstanceURL(t *testing.T) {
+	token := &Token{InstanceURL: "https://example.my.salesforce.com"}
+
+	client := &Client{token: token}
+	if got := client.instanceURL(); got != token.InstanceURL {
+		t.Errorf("instanceURL() = %q, want the instance of the token", got)
+	}
+
+	client.HostURL = "https://api.example.com"
+	if got := client.instanceURL(); got != client.HostURL {
+		t.Errorf("instanceURL() = %q, want the configured host", got)
+	}
+}
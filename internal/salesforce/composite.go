@@ -0,0 +1,108 @@
+package salesforce
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// MaxBatchSubrequests is the number of subrequests Salesforce accepts in one
+// Composite/Batch request.
+const MaxBatchSubrequests = 25
+
+// BatchSubrequest is a single request of a Composite/Batch request. URL is
+// relative to /services/data, e.g. "v59.0/sobjects/Account/describe".
+type BatchSubrequest struct {
+	Method      string            `json:"method"`
+	URL         string            `json:"url"`
+	HTTPHeaders map[string]string `json:"httpHeaders,omitempty"`
+}
+
+// BatchResult is the response to a single subrequest of a Composite/Batch
+// request.
+type BatchResult struct {
+	StatusCode int             `json:"statusCode"`
+	Result     json.RawMessage `json:"result"`
+	// Header holds the headers of the Composite/Batch response the result
+	// was part of, as subrequests have no headers of their own.
+	Header http.Header `json:"-"`
+}
+
+// Err returns the APIError of a failed subrequest, or nil.
+func (r BatchResult) Err() error {
+	if r.StatusCode >= http.StatusOK && r.StatusCode < http.StatusMultipleChoices {
+		return nil
+	}
+
+	return newAPIError(r.StatusCode, r.Result)
+}
+
+type batchRequest struct {
+	BatchRequests []BatchSubrequest `json:"batchRequests"`
+	HaltOnError   bool              `json:"haltOnError"`
+}
+
+type batchResponse struct {
+	HasErrors bool          `json:"hasErrors"`
+	Results   []BatchResult `json:"results"`
+}
+
+// Batch - Sends subrequests through the Composite/Batch API, split into as
+// few requests as the subrequest limit allows. Results are in the order of
+// subrequests, failed subrequests do not fail the others.
+func (c *Client) Batch(ctx context.Context, subrequests []BatchSubrequest) ([]BatchResult, error) {
+	results := make([]BatchResult, 0, len(subrequests))
+
+	for start := 0; start < len(subrequests); start += MaxBatchSubrequests {
+		end := start + MaxBatchSubrequests
+		if end > len(subrequests) {
+			end = len(subrequests)
+		}
+
+		payload, err := json.Marshal(batchRequest{BatchRequests: subrequests[start:end]})
+		if err != nil {
+			return nil, err
+		}
+
+		req, err := http.NewRequestWithContext(
+			ctx,
+			"POST",
+			fmt.Sprintf(
+				"%s/services/data/%s/composite/batch",
+				c.instanceURL(),
+				c.ApiVersion,
+			),
+			bytes.NewReader(payload),
+		)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", "application/json")
+
+		res, body, err := c.do(req)
+		if err != nil {
+			return nil, err
+		}
+		if res.StatusCode != http.StatusOK {
+			return nil, newAPIError(res.StatusCode, body)
+		}
+
+		response := &batchResponse{}
+		err = json.Unmarshal(body, response)
+		if err != nil {
+			return nil, err
+		}
+		if len(response.Results) != end-start {
+			return nil, fmt.Errorf("composite batch returned %d results for %d subrequests", len(response.Results), end-start)
+		}
+
+		for i := range response.Results {
+			response.Results[i].Header = res.Header
+		}
+		results = append(results, response.Results...)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,121 @@
+package salesforce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// Types of custom fields. Formula fields have the type of their result.
+const (
+	CustomFieldTypeCheckbox            = "Checkbox"
+	CustomFieldTypeCurrency            = "Currency"
+	CustomFieldTypeDate                = "Date"
+	CustomFieldTypeDateTime            = "DateTime"
+	CustomFieldTypeEmail               = "Email"
+	CustomFieldTypeLongTextArea        = "LongTextArea"
+	CustomFieldTypeLookup              = "Lookup"
+	CustomFieldTypeMasterDetail        = "MasterDetail"
+	CustomFieldTypeMultiselectPicklist = "MultiselectPicklist"
+	CustomFieldTypeNumber              = "Number"
+	CustomFieldTypePercent             = "Percent"
+	CustomFieldTypePhone               = "Phone"
+	CustomFieldTypePicklist            = "Picklist"
+	CustomFieldTypeSummary             = "Summary"
+	CustomFieldTypeText                = "Text"
+	CustomFieldTypeTextArea            = "TextArea"
+	CustomFieldTypeURL                 = "Url"
+)
+
+// CustomField is the Metadata API component of a custom field. Its full name
+// is the API name of the object and of the field joined by a dot, e.g.
+// "vub_Training__c.vub_Duration__c". Elements are in the order of the
+// Metadata API WSDL.
+type CustomField struct {
+	FullName             string       `xml:"fullName"`
+	DefaultValue         string       `xml:"defaultValue,omitempty"`
+	DeleteConstraint     string       `xml:"deleteConstraint,omitempty"`
+	Description          string       `xml:"description,omitempty"`
+	ExternalID           bool         `xml:"externalId,omitempty"`
+	Formula              string       `xml:"formula,omitempty"`
+	FormulaTreatBlanksAs string       `xml:"formulaTreatBlanksAs,omitempty"`
+	InlineHelpText       string       `xml:"inlineHelpText,omitempty"`
+	Label                string       `xml:"label"`
+	Length               *int         `xml:"length,omitempty"`
+	Precision            *int         `xml:"precision,omitempty"`
+	ReferenceTo          string       `xml:"referenceTo,omitempty"`
+	RelationshipLabel    string       `xml:"relationshipLabel,omitempty"`
+	RelationshipName     string       `xml:"relationshipName,omitempty"`
+	Required             bool         `xml:"required,omitempty"`
+	Scale                *int         `xml:"scale,omitempty"`
+	SummarizedField      string       `xml:"summarizedField,omitempty"`
+	SummaryFilterItems   []FilterItem `xml:"summaryFilterItems,omitempty"`
+	SummaryForeignKey    string       `xml:"summaryForeignKey,omitempty"`
+	SummaryOperation     string       `xml:"summaryOperation,omitempty"`
+	TrackFeedHistory     bool         `xml:"trackFeedHistory,omitempty"`
+	TrackHistory         bool         `xml:"trackHistory,omitempty"`
+	TrackTrending        bool         `xml:"trackTrending,omitempty"`
+	Type                 string       `xml:"type,omitempty"`
+	Unique               bool         `xml:"unique,omitempty"`
+	ValueSet             *ValueSet    `xml:"valueSet,omitempty"`
+	VisibleLines         *int         `xml:"visibleLines,omitempty"`
+}
+
+// MetadataType returns the Metadata API type of custom fields.
+func (CustomField) MetadataType() string {
+	return "CustomField"
+}
+
+// FilterItem is a criterion of the records a roll-up summary field
+// aggregates, e.g. field "Opportunity.StageName", operation "equals" and
+// value "Closed Won".
+type FilterItem struct {
+	Field      string `xml:"field"`
+	Operation  string `xml:"operation"`
+	Value      string `xml:"value,omitempty"`
+	ValueField string `xml:"valueField,omitempty"`
+}
+
+// ValueSet holds the values of a picklist field, either defined on the field
+// or referencing a global value set by ValueSetName.
+type ValueSet struct {
+	ControllingField   string              `xml:"controllingField,omitempty"`
+	Restricted         bool                `xml:"restricted,omitempty"`
+	ValueSetDefinition *ValueSetDefinition `xml:"valueSetDefinition,omitempty"`
+	ValueSetName       string              `xml:"valueSetName,omitempty"`
+}
+
+// ValueSetDefinition lists the values defined on a picklist field.
+type ValueSetDefinition struct {
+	Sorted bool          `xml:"sorted"`
+	Values []CustomValue `xml:"value"`
+}
+
+// CustomValue is a value of a picklist.
+type CustomValue struct {
+	FullName    string `xml:"fullName"`
+	Color       string `xml:"color,omitempty"`
+	Default     bool   `xml:"default"`
+	Description string `xml:"description,omitempty"`
+	IsActive    *bool  `xml:"isActive,omitempty"`
+	Label       string `xml:"label"`
+}
+
+// GetCustomField - Returns the custom field with fullName, or an APIError
+// matching IsNotFound if it does not exist.
+func (c *Client) GetCustomField(ctx context.Context, fullName string) (*CustomField, error) {
+	var fields []CustomField
+	err := c.ReadMetadata(ctx, "CustomField", []string{fullName}, &fields)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(fields) == 0 {
+		return nil, &APIError{
+			StatusCode: http.StatusNotFound,
+			Errors:     []ErrorDetail{{ErrorCode: ErrorCodeNotFound, Message: fmt.Sprintf("custom field %s does not exist", fullName)}},
+		}
+	}
+
+	return &fields[0], nil
+}
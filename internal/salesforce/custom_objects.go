@@ -0,0 +1,73 @@
+package salesforce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// Sharing models of custom objects.
+const (
+	SharingModelPrivate            = "Private"
+	SharingModelRead               = "Read"
+	SharingModelReadWrite          = "ReadWrite"
+	SharingModelControlledByParent = "ControlledByParent"
+)
+
+// Deployment statuses of custom objects.
+const (
+	DeploymentStatusDeployed      = "Deployed"
+	DeploymentStatusInDevelopment = "InDevelopment"
+)
+
+// CustomObject is the Metadata API component of a custom object. Elements
+// are in the order of the Metadata API WSDL.
+type CustomObject struct {
+	FullName           string                 `xml:"fullName"`
+	DeploymentStatus   string                 `xml:"deploymentStatus,omitempty"`
+	Description        string                 `xml:"description,omitempty"`
+	EnableActivities   bool                   `xml:"enableActivities"`
+	EnableBulkAPI      bool                   `xml:"enableBulkApi"`
+	EnableHistory      bool                   `xml:"enableHistory"`
+	EnableReports      bool                   `xml:"enableReports"`
+	EnableSearch       bool                   `xml:"enableSearch"`
+	EnableSharing      bool                   `xml:"enableSharing"`
+	EnableStreamingAPI bool                   `xml:"enableStreamingApi"`
+	Label              string                 `xml:"label"`
+	NameField          *CustomObjectNameField `xml:"nameField"`
+	PluralLabel        string                 `xml:"pluralLabel"`
+	SharingModel       string                 `xml:"sharingModel,omitempty"`
+}
+
+// MetadataType returns the Metadata API type of custom objects.
+func (CustomObject) MetadataType() string {
+	return "CustomObject"
+}
+
+// CustomObjectNameField is the name field of a custom object, either Text or
+// AutoNumber with a display format such as "A-{0000}".
+type CustomObjectNameField struct {
+	DisplayFormat string `xml:"displayFormat,omitempty"`
+	Label         string `xml:"label"`
+	TrackHistory  bool   `xml:"trackHistory"`
+	Type          string `xml:"type"`
+}
+
+// GetCustomObject - Returns the custom object with fullName, or an APIError
+// matching IsNotFound if it does not exist.
+func (c *Client) GetCustomObject(ctx context.Context, fullName string) (*CustomObject, error) {
+	var objects []CustomObject
+	err := c.ReadMetadata(ctx, "CustomObject", []string{fullName}, &objects)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(objects) == 0 {
+		return nil, &APIError{
+			StatusCode: http.StatusNotFound,
+			Errors:     []ErrorDetail{{ErrorCode: ErrorCodeNotFound, Message: fmt.Sprintf("custom object %s does not exist", fullName)}},
+		}
+	}
+
+	return &objects[0], nil
+}
@@ -1,19 +1,30 @@
 package salesforce
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-// GetDescription - Returns a specific description.
-func (c *Client) GetDescription(sfObject string) (*Description, error) {
+// GetDescription - Returns a specific description. With a DescribeCache,
+// stored descriptions are revalidated with If-Modified-Since.
+func (c *Client) GetDescription(ctx context.Context, sfObject string) (*Description, error) {
+
+	key := c.describeCacheKey(sfObject)
+	cached := c.DescribeCache.load(ctx, key)
+	if cached != nil && cached.fresh(c.DescribeCache.MaxAge) {
+		return unmarshalDescription(cached.Description)
+	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
 		fmt.Sprintf(
 			"%s/services/data/%s/sobjects/%s/describe",
-			c.HostURL,
+			c.instanceURL(),
 			c.ApiVersion,
 			sfObject,
 		),
@@ -22,17 +33,136 @@ func (c *Client) GetDescription(sfObject string) (*Description, error) {
 	if err != nil {
 		return nil, err
 	}
+	if cached != nil {
+		req.Header.Set("If-Modified-Since", cached.LastModified)
+	}
 
-	body, err := c.doRequest(req)
+	res, body, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case res.StatusCode == http.StatusNotModified && cached != nil:
+		tflog.Debug(ctx, "Salesforce description not modified, using cache", map[string]any{"sobject": sfObject})
+		body = cached.Description
+		c.DescribeCache.store(ctx, key, cached.LastModified, body)
+	case res.StatusCode == http.StatusOK:
+		c.DescribeCache.store(ctx, key, lastModified(res.Header), body)
+	default:
+		return nil, newAPIError(res.StatusCode, body)
+	}
+
+	return unmarshalDescription(body)
+}
+
+// ForgetDescription - Removes the cached description of sfObject, so the next
+// GetDescription fetches it again after its metadata was changed.
+func (c *Client) ForgetDescription(ctx context.Context, sfObject string) {
+	c.DescribeCache.remove(ctx, c.describeCacheKey(sfObject))
+}
+
+// GetDescriptions - Returns the descriptions of several sObjects, fetched
+// through the Composite/Batch API. Descriptions are in the order of sfObjects.
+func (c *Client) GetDescriptions(ctx context.Context, sfObjects []string) ([]*Description, error) {
+
+	descriptions := make([]*Description, len(sfObjects))
+	keys := make([]describeCacheKey, len(sfObjects))
+	cached := make([]*describeCacheEntry, len(sfObjects))
+
+	var subrequests []BatchSubrequest
+	var pending []int
+	for i, sfObject := range sfObjects {
+		keys[i] = c.describeCacheKey(sfObject)
+		cached[i] = c.DescribeCache.load(ctx, keys[i])
+		if cached[i] != nil && cached[i].fresh(c.DescribeCache.MaxAge) {
+			description, err := unmarshalDescription(cached[i].Description)
+			if err != nil {
+				return nil, err
+			}
+			descriptions[i] = description
+			continue
+		}
+
+		subrequest := BatchSubrequest{
+			Method: "GET",
+			URL:    fmt.Sprintf("%s/sobjects/%s/describe", c.ApiVersion, sfObject),
+		}
+		if cached[i] != nil {
+			subrequest.HTTPHeaders = map[string]string{"If-Modified-Since": cached[i].LastModified}
+		}
+		subrequests = append(subrequests, subrequest)
+		pending = append(pending, i)
+	}
+	if len(subrequests) == 0 {
+		return descriptions, nil
+	}
+
+	results, err := c.Batch(ctx, subrequests)
 	if err != nil {
 		return nil, err
 	}
+	for j, result := range results {
+		i := pending[j]
+		body := []byte(result.Result)
+
+		switch {
+		case result.StatusCode == http.StatusNotModified && cached[i] != nil:
+			body = cached[i].Description
+			c.DescribeCache.store(ctx, keys[i], cached[i].LastModified, body)
+		case result.Err() == nil:
+			c.DescribeCache.store(ctx, keys[i], lastModified(result.Header), body)
+		default:
+			return nil, fmt.Errorf("describing %s: %w", sfObjects[i], result.Err())
+		}
+
+		descriptions[i], err = unmarshalDescription(body)
+		if err != nil {
+			return nil, err
+		}
+	}
 
+	return descriptions, nil
+}
+
+// unmarshalDescription parses a describe response body.
+func unmarshalDescription(body []byte) (*Description, error) {
 	description := &Description{}
-	err = json.Unmarshal(body, description)
+	err := json.Unmarshal(body, description)
 	if err != nil {
 		return nil, err
 	}
 
 	return description, nil
 }
+
+// DescribeGlobal - Returns the list of sObjects available in the org.
+func (c *Client) DescribeGlobal(ctx context.Context) (*GlobalDescription, error) {
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"GET",
+		fmt.Sprintf(
+			"%s/services/data/%s/sobjects",
+			c.instanceURL(),
+			c.ApiVersion,
+		),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	globalDescription := &GlobalDescription{}
+	err = json.Unmarshal(body, globalDescription)
+	if err != nil {
+		return nil, err
+	}
+
+	return globalDescription, nil
+}
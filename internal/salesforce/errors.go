@@ -0,0 +1,149 @@
+package salesforce
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Error codes returned by the Salesforce REST API.
+const (
+	ErrorCodeNotFound             = "NOT_FOUND"
+	ErrorCodeInvalidType          = "INVALID_TYPE"
+	ErrorCodeInvalidField         = "INVALID_FIELD"
+	ErrorCodeMalformedQuery       = "MALFORMED_QUERY"
+	ErrorCodeInvalidSessionID     = "INVALID_SESSION_ID"
+	ErrorCodeInsufficientAccess   = "INSUFFICIENT_ACCESS"
+	ErrorCodeRequestLimitExceeded = "REQUEST_LIMIT_EXCEEDED"
+)
+
+// OAuth error codes returned by the Salesforce token endpoint.
+const (
+	AuthErrorInvalidGrant         = "invalid_grant"
+	AuthErrorInvalidClientID      = "invalid_client_id"
+	AuthErrorInvalidClient        = "invalid_client"
+	AuthErrorUnsupportedGrantType = "unsupported_grant_type"
+	AuthErrorInactiveUser         = "inactive_user"
+	AuthErrorInactiveOrg          = "inactive_org"
+	AuthErrorRateLimitExceeded    = "rate_limit_exceeded"
+)
+
+// AuthError is an error response of the Salesforce OAuth token endpoint.
+type AuthError struct {
+	StatusCode  int
+	ErrorCode   string `json:"error"`
+	Description string `json:"error_description"`
+}
+
+// newAuthError parses the OAuth error in body. Bodies that are not an
+// OAuth error are kept as description.
+func newAuthError(statusCode int, body []byte) *AuthError {
+	authErr := &AuthError{}
+	if err := json.Unmarshal(body, authErr); err != nil || authErr.ErrorCode == "" {
+		authErr.Description = strings.TrimSpace(string(body))
+	}
+	authErr.StatusCode = statusCode
+
+	return authErr
+}
+
+func (e *AuthError) Error() string {
+	if e.ErrorCode == "" {
+		return fmt.Sprintf("authentication failed with status %d: %s", e.StatusCode, e.Description)
+	}
+
+	return fmt.Sprintf("authentication failed with status %d: %s: %s", e.StatusCode, e.ErrorCode, e.Description)
+}
+
+// APIError is an error response of the Salesforce REST API.
+type APIError struct {
+	StatusCode int
+	Errors     []ErrorDetail
+}
+
+// ErrorDetail is a single entry of the error array Salesforce responds with.
+type ErrorDetail struct {
+	ErrorCode string   `json:"errorCode"`
+	Message   string   `json:"message"`
+	Fields    []string `json:"fields"`
+}
+
+// newAPIError parses the error array in body. Bodies that are not such an
+// array are kept as message of a single error detail.
+func newAPIError(statusCode int, body []byte) *APIError {
+	apiErr := &APIError{StatusCode: statusCode}
+	if err := json.Unmarshal(body, &apiErr.Errors); err != nil || len(apiErr.Errors) == 0 {
+		apiErr.Errors = []ErrorDetail{{Message: strings.TrimSpace(string(body))}}
+	}
+
+	return apiErr
+}
+
+func (e *APIError) Error() string {
+	messages := make([]string, 0, len(e.Errors))
+	for _, detail := range e.Errors {
+		message := detail.Message
+		if detail.ErrorCode != "" {
+			message = detail.ErrorCode + ": " + message
+		}
+		if len(detail.Fields) > 0 {
+			message += fmt.Sprintf(" (fields: %s)", strings.Join(detail.Fields, ", "))
+		}
+		messages = append(messages, message)
+	}
+
+	return fmt.Sprintf("status: %d, %s", e.StatusCode, strings.Join(messages, "; "))
+}
+
+// HasErrorCode reports whether any error detail carries one of codes.
+func (e *APIError) HasErrorCode(codes ...string) bool {
+	for _, detail := range e.Errors {
+		for _, code := range codes {
+			if detail.ErrorCode == code {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Fields returns the names of all fields the error details refer to.
+func (e *APIError) Fields() []string {
+	var fields []string
+	for _, detail := range e.Errors {
+		fields = append(fields, detail.Fields...)
+	}
+
+	return fields
+}
+
+// IsErrorCode reports whether err is an APIError carrying one of codes.
+func IsErrorCode(err error, codes ...string) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.HasErrorCode(codes...)
+}
+
+// IsNotFound reports whether err means the requested object or record does
+// not exist.
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+
+	return apiErr.StatusCode == http.StatusNotFound || apiErr.HasErrorCode(ErrorCodeNotFound, ErrorCodeInvalidType)
+}
+
+// IsForbidden reports whether err means the user lacks the permission for
+// the request.
+func IsForbidden(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+
+	return apiErr.StatusCode == http.StatusForbidden || apiErr.HasErrorCode(ErrorCodeInsufficientAccess)
+}
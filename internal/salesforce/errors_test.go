@@ -0,0 +1,97 @@
+package salesforce
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestNewAPIError(t *testing.T) {
+	tests := map[string]struct {
+		statusCode int
+		body       string
+		want       string
+		wantCode   string
+	}{
+		"error array": {
+			statusCode: http.StatusBadRequest,
+			body:       `[{"message":"No such column 'Foo__c' on entity 'Account'","errorCode":"INVALID_FIELD","fields":["Foo__c"]}]`,
+			want:       "status: 400, INVALID_FIELD: No such column 'Foo__c' on entity 'Account' (fields: Foo__c)",
+			wantCode:   ErrorCodeInvalidField,
+		},
+		"several errors": {
+			statusCode: http.StatusBadRequest,
+			body:       `[{"message":"first","errorCode":"UNABLE_TO_LOCK_ROW"},{"message":"second","errorCode":"MALFORMED_QUERY"}]`,
+			want:       "status: 400, UNABLE_TO_LOCK_ROW: first; MALFORMED_QUERY: second",
+			wantCode:   ErrorCodeMalformedQuery,
+		},
+		"plain body": {
+			statusCode: http.StatusInternalServerError,
+			body:       "  upstream failure\n",
+			want:       "status: 500, upstream failure",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			apiErr := newAPIError(test.statusCode, []byte(test.body))
+			if got := apiErr.Error(); got != test.want {
+				t.Errorf("Error() = %q, want %q", got, test.want)
+			}
+			if test.wantCode != "" && !apiErr.HasErrorCode(test.wantCode) {
+				t.Errorf("HasErrorCode(%q) = false", test.wantCode)
+			}
+		})
+	}
+}
+
+func TestNewAuthError(t *testing.T) {
+	authErr := newAuthError(http.StatusBadRequest, []byte(`{"error":"invalid_grant","error_description":"authentication failure"}`))
+	if authErr.ErrorCode != AuthErrorInvalidGrant || authErr.Description != "authentication failure" {
+		t.Errorf("newAuthError() = %+v", authErr)
+	}
+
+	authErr = newAuthError(http.StatusBadGateway, []byte("<html>Bad Gateway</html>"))
+	if authErr.ErrorCode != "" || authErr.Description != "<html>Bad Gateway</html>" {
+		t.Errorf("newAuthError() = %+v", authErr)
+	}
+}
+
+func TestErrorClassification(t *testing.T) {
+	tests := map[string]struct {
+		err           error
+		wantNotFound  bool
+		wantForbidden bool
+	}{
+		"404": {
+			err:          &APIError{StatusCode: http.StatusNotFound},
+			wantNotFound: true,
+		},
+		"invalid type": {
+			err:          &APIError{StatusCode: http.StatusBadRequest, Errors: []ErrorDetail{{ErrorCode: ErrorCodeInvalidType}}},
+			wantNotFound: true,
+		},
+		"wrapped 403": {
+			err:           fmt.Errorf("reading record: %w", &APIError{StatusCode: http.StatusForbidden}),
+			wantForbidden: true,
+		},
+		"insufficient access": {
+			err:           &APIError{StatusCode: http.StatusBadRequest, Errors: []ErrorDetail{{ErrorCode: ErrorCodeInsufficientAccess}}},
+			wantForbidden: true,
+		},
+		"other error": {
+			err: fmt.Errorf("status: 404"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := IsNotFound(test.err); got != test.wantNotFound {
+				t.Errorf("IsNotFound() = %t, want %t", got, test.wantNotFound)
+			}
+			if got := IsForbidden(test.err); got != test.wantForbidden {
+				t.Errorf("IsForbidden() = %t, want %t", got, test.wantForbidden)
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package salesforce
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// PermissionSet is a PermissionSet record. Each profile owns a permission
+// set holding its permissions, which has the profile set. The Metadata API
+// component is PermissionSetMetadata.
+type PermissionSet struct {
+	ID               string `json:"Id"`
+	Name             string `json:"Name"`
+	IsOwnedByProfile bool   `json:"IsOwnedByProfile"`
+	Profile          *struct {
+		Name string `json:"Name"`
+	} `json:"Profile"`
+}
+
+// ProfileName returns the name of the profile owning the permission set, or
+// "" for permission sets of their own.
+func (p PermissionSet) ProfileName() string {
+	if !p.IsOwnedByProfile || p.Profile == nil {
+		return ""
+	}
+
+	return p.Profile.Name
+}
+
+// FieldPermissions is a FieldPermissions record, the access of a permission
+// set or profile to a field. Salesforce only stores records granting at
+// least read access.
+type FieldPermissions struct {
+	ID              string         `json:"Id"`
+	ParentID        string         `json:"ParentId"`
+	Parent          *PermissionSet `json:"Parent"`
+	SObjectType     string         `json:"SobjectType"`
+	Field           string         `json:"Field"`
+	PermissionsRead bool           `json:"PermissionsRead"`
+	PermissionsEdit bool           `json:"PermissionsEdit"`
+}
+
+// GetPermissionSets - Returns the permission sets with the API names and
+// the permission sets owned by the profiles with the profile names.
+func (c *Client) GetPermissionSets(ctx context.Context, names, profileNames []string) ([]PermissionSet, error) {
+	conditions := []string{}
+	if len(names) > 0 {
+		conditions = append(conditions, "Name IN "+soqlList(names))
+	}
+	if len(profileNames) > 0 {
+		conditions = append(conditions, "Profile.Name IN "+soqlList(profileNames))
+	}
+	if len(conditions) == 0 {
+		return nil, nil
+	}
+
+	result, err := c.Query(ctx, "SELECT Id, Name, IsOwnedByProfile, Profile.Name FROM PermissionSet WHERE "+strings.Join(conditions, " OR "), QueryOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalRecords[PermissionSet](result.Records)
+}
+
+// GetFieldPermissions - Returns the FieldPermissions records of field, the
+// API names of the object and the field joined by a dot, with their
+// permission sets.
+func (c *Client) GetFieldPermissions(ctx context.Context, field string) ([]FieldPermissions, error) {
+	sObjectType, _, _ := strings.Cut(field, ".")
+	soql := fmt.Sprintf(
+		"SELECT Id, ParentId, Parent.Name, Parent.IsOwnedByProfile, Parent.Profile.Name, SobjectType, Field, PermissionsRead, PermissionsEdit "+
+			"FROM FieldPermissions WHERE SobjectType = %s AND Field = %s",
+		soqlString(sObjectType),
+		soqlString(field),
+	)
+
+	result, err := c.Query(ctx, soql, QueryOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalRecords[FieldPermissions](result.Records)
+}
+
+// unmarshalRecords decodes records of a query result.
+func unmarshalRecords[T any](records []json.RawMessage) ([]T, error) {
+	result := make([]T, 0, len(records))
+	for _, raw := range records {
+		var record T
+		err := json.Unmarshal(raw, &record)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, record)
+	}
+
+	return result, nil
+}
+
+// soqlString quotes value as SOQL string literal.
+func soqlString(value string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(value) + "'"
+}
+
+// soqlList quotes values as SOQL list of string literals for IN conditions.
+func soqlList(values []string) string {
+	quoted := make([]string, 0, len(values))
+	for _, value := range values {
+		quoted = append(quoted, soqlString(value))
+	}
+
+	return "(" + strings.Join(quoted, ", ") + ")"
+}
@@ -0,0 +1,486 @@
+package salesforce
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// metadataNamespace is the XML namespace of the Metadata API.
+const metadataNamespace = "http://soap.sforce.com/2006/04/metadata"
+
+// xsiNamespace is the XML namespace of the xsi:type attribute.
+const xsiNamespace = "http://www.w3.org/2001/XMLSchema-instance"
+
+// DefaultMetadataPollInterval is the first wait between status checks of
+// asynchronous Metadata API operations. Later waits grow up to
+// maxMetadataPollInterval.
+const DefaultMetadataPollInterval = 2 * time.Second
+
+const maxMetadataPollInterval = 30 * time.Second
+
+// Metadata is a component of the Metadata API, such as a CustomObject.
+type Metadata interface {
+	// MetadataType returns the type of the component, e.g. "CustomObject".
+	MetadataType() string
+}
+
+// MetadataSaveResult is the result of creating, updating or deleting a
+// component.
+type MetadataSaveResult struct {
+	FullName string          `xml:"fullName"`
+	Success  bool            `xml:"success"`
+	Errors   []MetadataError `xml:"errors"`
+}
+
+// Err returns the errors of a failed operation, or nil.
+func (r MetadataSaveResult) Err() error {
+	if r.Success {
+		return nil
+	}
+
+	return &MetadataResultError{FullName: r.FullName, Errors: r.Errors}
+}
+
+// MetadataUpsertResult is the result of upserting a component.
+type MetadataUpsertResult struct {
+	FullName string          `xml:"fullName"`
+	Success  bool            `xml:"success"`
+	Created  bool            `xml:"created"`
+	Errors   []MetadataError `xml:"errors"`
+}
+
+// Err returns the errors of a failed operation, or nil.
+func (r MetadataUpsertResult) Err() error {
+	if r.Success {
+		return nil
+	}
+
+	return &MetadataResultError{FullName: r.FullName, Errors: r.Errors}
+}
+
+// MetadataError is an error of an operation on a single component.
+type MetadataError struct {
+	StatusCode string   `xml:"statusCode"`
+	Message    string   `xml:"message"`
+	Fields     []string `xml:"fields"`
+}
+
+// MetadataResultError is the error of a failed operation on a component.
+type MetadataResultError struct {
+	FullName string
+	Errors   []MetadataError
+}
+
+func (e *MetadataResultError) Error() string {
+	messages := make([]string, 0, len(e.Errors))
+	for _, detail := range e.Errors {
+		message := detail.Message
+		if detail.StatusCode != "" {
+			message = detail.StatusCode + ": " + message
+		}
+		if len(detail.Fields) > 0 {
+			message += fmt.Sprintf(" (fields: %s)", strings.Join(detail.Fields, ", "))
+		}
+		messages = append(messages, message)
+	}
+
+	return fmt.Sprintf("%s: %s", e.FullName, strings.Join(messages, "; "))
+}
+
+// HasStatusCode reports whether any error carries one of codes.
+func (e *MetadataResultError) HasStatusCode(codes ...string) bool {
+	for _, detail := range e.Errors {
+		for _, code := range codes {
+			if detail.StatusCode == code {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// StatusCodeInvalidCrossReferenceKey is the status code of operations on
+// components that do not exist.
+const StatusCodeInvalidCrossReferenceKey = "INVALID_CROSS_REFERENCE_KEY"
+
+// IsComponentNotFound reports whether err is the result of an operation on
+// a component that does not exist.
+func IsComponentNotFound(err error) bool {
+	var resultErr *MetadataResultError
+	if !errors.As(err, &resultErr) {
+		return false
+	}
+
+	return resultErr.HasStatusCode(StatusCodeInvalidCrossReferenceKey)
+}
+
+// SOAPFault is a fault response of the Metadata API, which fails the whole
+// call, e.g. for an invalid session or a malformed request.
+type SOAPFault struct {
+	StatusCode int
+	// Code is the fault code without its namespace prefix, e.g.
+	// "INVALID_SESSION_ID".
+	Code    string `xml:"faultcode"`
+	Message string `xml:"faultstring"`
+}
+
+func (e *SOAPFault) Error() string {
+	return fmt.Sprintf("status: %d, %s: %s", e.StatusCode, e.Code, e.Message)
+}
+
+// parseSOAPFault returns the fault in a SOAP response body, or nil.
+func parseSOAPFault(statusCode int, body []byte) *SOAPFault {
+	envelope := &soapEnvelope{}
+	if err := xml.Unmarshal(body, envelope); err != nil || envelope.Body.Fault == nil {
+		return nil
+	}
+
+	fault := envelope.Body.Fault
+	fault.StatusCode = statusCode
+	if _, code, ok := strings.Cut(fault.Code, ":"); ok {
+		fault.Code = code
+	}
+
+	return fault
+}
+
+// FileProperties describes a component listed or retrieved through the
+// Metadata API.
+type FileProperties struct {
+	FullName           string `xml:"fullName"`
+	FileName           string `xml:"fileName"`
+	Type               string `xml:"type"`
+	ID                 string `xml:"id"`
+	NamespacePrefix    string `xml:"namespacePrefix"`
+	ManageableState    string `xml:"manageableState"`
+	CreatedByName      string `xml:"createdByName"`
+	CreatedDate        string `xml:"createdDate"`
+	LastModifiedByName string `xml:"lastModifiedByName"`
+	LastModifiedDate   string `xml:"lastModifiedDate"`
+}
+
+// ListMetadataQuery selects the components of a type, and for foldered
+// types such as Report, of a folder.
+type ListMetadataQuery struct {
+	Type   string `xml:"type"`
+	Folder string `xml:"folder,omitempty"`
+}
+
+type soapEnvelope struct {
+	Body struct {
+		Fault   *SOAPFault `xml:"Fault"`
+		Content []byte     `xml:",innerxml"`
+	} `xml:"Body"`
+}
+
+// metadataItem marshals a component with its xsi:type, which the Metadata
+// API needs to tell the types of components apart. The element declares the
+// xsi prefix itself, so it does not depend on the envelope it is sent in.
+type metadataItem struct {
+	Metadata
+}
+
+func (m metadataItem) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Attr = append(start.Attr,
+		xml.Attr{Name: xml.Name{Local: "xmlns:xsi"}, Value: xsiNamespace},
+		xml.Attr{Name: xml.Name{Local: "xsi:type"}, Value: m.MetadataType()},
+	)
+	return e.EncodeElement(m.Metadata, start)
+}
+
+func metadataItems(components []Metadata) []metadataItem {
+	items := make([]metadataItem, 0, len(components))
+	for _, component := range components {
+		items = append(items, metadataItem{component})
+	}
+
+	return items
+}
+
+// metadataVersion returns the API version in the form of the Metadata API,
+// e.g. "59.0" for "v59.0".
+func (c *Client) metadataVersion() string {
+	return strings.TrimPrefix(c.ApiVersion, "v")
+}
+
+// callMetadata sends request as SOAP call to the Metadata API and decodes the
+// content of the response body into response.
+func (c *Client) callMetadata(ctx context.Context, request, response any) error {
+	payload, err := xml.Marshal(request)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"POST",
+		fmt.Sprintf(
+			"%s/services/Soap/m/%s",
+			c.instanceURL(),
+			c.metadataVersion(),
+		),
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "text/xml; charset=UTF-8")
+	req.Header.Set("SOAPAction", `""`)
+
+	// The session is part of the envelope, so the envelope is built again
+	// with the refreshed token when the request is resent.
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(c.soapEnvelope(payload))), nil
+	}
+	req.Body, _ = req.GetBody()
+
+	res, body, err := c.do(req)
+	if err != nil {
+		return err
+	}
+
+	if fault := parseSOAPFault(res.StatusCode, body); fault != nil {
+		return fault
+	}
+	if res.StatusCode != http.StatusOK {
+		return newAPIError(res.StatusCode, body)
+	}
+
+	envelope := &soapEnvelope{}
+	err = xml.Unmarshal(body, envelope)
+	if err != nil {
+		return err
+	}
+
+	return xml.Unmarshal(envelope.Body.Content, response)
+}
+
+// soapEnvelope wraps payload in a SOAP envelope with the session of the
+// current token.
+func (c *Client) soapEnvelope(payload []byte) []byte {
+	var sessionID bytes.Buffer
+	if token := c.currentToken(); token != nil {
+		_ = xml.EscapeText(&sessionID, []byte(token.AccessToken))
+	}
+
+	var envelope bytes.Buffer
+	envelope.WriteString(xml.Header)
+	envelope.WriteString(`<soapenv:Envelope xmlns:soapenv="http://schemas.xmlsoap.org/soap/envelope/" ` +
+		`xmlns:xsi="` + xsiNamespace + `" xmlns="` + metadataNamespace + `">`)
+	envelope.WriteString(`<soapenv:Header><SessionHeader><sessionId>`)
+	envelope.Write(sessionID.Bytes())
+	envelope.WriteString(`</sessionId></SessionHeader></soapenv:Header>`)
+	envelope.WriteString(`<soapenv:Body>`)
+	envelope.Write(payload)
+	envelope.WriteString(`</soapenv:Body></soapenv:Envelope>`)
+
+	return envelope.Bytes()
+}
+
+// CreateMetadata - Creates components. Each component has its own result,
+// failures of single components do not fail the call.
+func (c *Client) CreateMetadata(ctx context.Context, components []Metadata) ([]MetadataSaveResult, error) {
+	request := struct {
+		XMLName  xml.Name       `xml:"createMetadata"`
+		Metadata []metadataItem `xml:"metadata"`
+	}{Metadata: metadataItems(components)}
+
+	response := struct {
+		Results []MetadataSaveResult `xml:"result"`
+	}{}
+	err := c.callMetadata(ctx, request, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Results, nil
+}
+
+// UpdateMetadata - Updates components, replacing all their settings. Each
+// component has its own result.
+func (c *Client) UpdateMetadata(ctx context.Context, components []Metadata) ([]MetadataSaveResult, error) {
+	request := struct {
+		XMLName  xml.Name       `xml:"updateMetadata"`
+		Metadata []metadataItem `xml:"metadata"`
+	}{Metadata: metadataItems(components)}
+
+	response := struct {
+		Results []MetadataSaveResult `xml:"result"`
+	}{}
+	err := c.callMetadata(ctx, request, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Results, nil
+}
+
+// UpsertMetadata - Creates components or updates them if they exist. Each
+// component has its own result.
+func (c *Client) UpsertMetadata(ctx context.Context, components []Metadata) ([]MetadataUpsertResult, error) {
+	request := struct {
+		XMLName  xml.Name       `xml:"upsertMetadata"`
+		Metadata []metadataItem `xml:"metadata"`
+	}{Metadata: metadataItems(components)}
+
+	response := struct {
+		Results []MetadataUpsertResult `xml:"result"`
+	}{}
+	err := c.callMetadata(ctx, request, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Results, nil
+}
+
+// DeleteMetadata - Deletes the components of metadataType with fullNames.
+// Each component has its own result.
+func (c *Client) DeleteMetadata(ctx context.Context, metadataType string, fullNames []string) ([]MetadataSaveResult, error) {
+	request := struct {
+		XMLName   xml.Name `xml:"deleteMetadata"`
+		Type      string   `xml:"type"`
+		FullNames []string `xml:"fullNames"`
+	}{Type: metadataType, FullNames: fullNames}
+
+	response := struct {
+		Results []MetadataSaveResult `xml:"result"`
+	}{}
+	err := c.callMetadata(ctx, request, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Results, nil
+}
+
+// ReadMetadata - Reads the components of metadataType with fullNames into
+// records, a pointer to a slice of the Go type of the components, e.g.
+// *[]CustomObject. Components that do not exist are left out.
+func (c *Client) ReadMetadata(ctx context.Context, metadataType string, fullNames []string, records any) error {
+	slice := reflect.ValueOf(records)
+	if slice.Kind() != reflect.Pointer || slice.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("records must be a pointer to a slice, got %T", records)
+	}
+	slice = slice.Elem()
+
+	request := struct {
+		XMLName   xml.Name `xml:"readMetadata"`
+		Type      string   `xml:"type"`
+		FullNames []string `xml:"fullNames"`
+	}{Type: metadataType, FullNames: fullNames}
+
+	response := struct {
+		Records []struct {
+			FullName string `xml:"fullName"`
+			Content  []byte `xml:",innerxml"`
+		} `xml:"result>records"`
+	}{}
+	err := c.callMetadata(ctx, request, &response)
+	if err != nil {
+		return err
+	}
+
+	for _, raw := range response.Records {
+		// Components that do not exist are returned without content
+		if raw.FullName == "" {
+			continue
+		}
+
+		record := reflect.New(slice.Type().Elem())
+		err = xml.Unmarshal(append(append([]byte("<records>"), raw.Content...), "</records>"...), record.Interface())
+		if err != nil {
+			return err
+		}
+		slice.Set(reflect.Append(slice, record.Elem()))
+	}
+
+	return nil
+}
+
+// ListMetadata - Lists the components matching queries.
+func (c *Client) ListMetadata(ctx context.Context, queries []ListMetadataQuery) ([]FileProperties, error) {
+	request := struct {
+		XMLName     xml.Name            `xml:"listMetadata"`
+		Queries     []ListMetadataQuery `xml:"queries"`
+		AsOfVersion string              `xml:"asOfVersion"`
+	}{Queries: queries, AsOfVersion: c.metadataVersion()}
+
+	response := struct {
+		Results []FileProperties `xml:"result"`
+	}{}
+	err := c.callMetadata(ctx, request, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Results, nil
+}
+
+// SaveMetadata - Creates or updates a single component and returns its
+// error, if any.
+func (c *Client) SaveMetadata(ctx context.Context, component Metadata, create bool) error {
+	var results []MetadataSaveResult
+	var err error
+	if create {
+		results, err = c.CreateMetadata(ctx, []Metadata{component})
+	} else {
+		results, err = c.UpdateMetadata(ctx, []Metadata{component})
+	}
+	if err != nil {
+		return err
+	}
+	if len(results) != 1 {
+		return fmt.Errorf("expected 1 result of saving %s, got %d", component.MetadataType(), len(results))
+	}
+
+	return results[0].Err()
+}
+
+// DeleteComponent - Deletes a single component of metadataType and returns
+// its error, if any.
+func (c *Client) DeleteComponent(ctx context.Context, metadataType, fullName string) error {
+	results, err := c.DeleteMetadata(ctx, metadataType, []string{fullName})
+	if err != nil {
+		return err
+	}
+	if len(results) != 1 {
+		return fmt.Errorf("expected 1 result of deleting %s, got %d", metadataType, len(results))
+	}
+
+	return results[0].Err()
+}
+
+// pollMetadata calls check until it reports the asynchronous operation done,
+// waiting longer between checks the longer the operation takes.
+func (c *Client) pollMetadata(ctx context.Context, check func() (bool, error)) error {
+	interval := c.MetadataPollInterval
+	if interval <= 0 {
+		interval = DefaultMetadataPollInterval
+	}
+
+	for {
+		done, err := check()
+		if err != nil || done {
+			return err
+		}
+
+		err = sleep(ctx, interval)
+		if err != nil {
+			return err
+		}
+		interval = interval * 3 / 2
+		if interval > maxMetadataPollInterval {
+			interval = maxMetadataPollInterval
+		}
+	}
+}
@@ -0,0 +1,254 @@
+package salesforce
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"strings"
+)
+
+// DeployOptions controls how a zip package is deployed.
+type DeployOptions struct {
+	AllowMissingFiles bool     `xml:"allowMissingFiles"`
+	AutoUpdatePackage bool     `xml:"autoUpdatePackage"`
+	CheckOnly         bool     `xml:"checkOnly"`
+	IgnoreWarnings    bool     `xml:"ignoreWarnings"`
+	PerformRetrieve   bool     `xml:"performRetrieve"`
+	PurgeOnDelete     bool     `xml:"purgeOnDelete"`
+	RollbackOnError   bool     `xml:"rollbackOnError"`
+	RunTests          []string `xml:"runTests,omitempty"`
+	SinglePackage     bool     `xml:"singlePackage"`
+	// TestLevel is one of NoTestRun, RunSpecifiedTests, RunLocalTests or
+	// RunAllTestsInOrg. Empty uses the default of the org.
+	TestLevel string `xml:"testLevel,omitempty"`
+}
+
+// DeployResult is the status of a deployment.
+type DeployResult struct {
+	ID                       string        `xml:"id"`
+	Done                     bool          `xml:"done"`
+	Status                   string        `xml:"status"`
+	Success                  bool          `xml:"success"`
+	CheckOnly                bool          `xml:"checkOnly"`
+	NumberComponentsDeployed int           `xml:"numberComponentsDeployed"`
+	NumberComponentErrors    int           `xml:"numberComponentErrors"`
+	NumberComponentsTotal    int           `xml:"numberComponentsTotal"`
+	NumberTestErrors         int           `xml:"numberTestErrors"`
+	ErrorStatusCode          string        `xml:"errorStatusCode"`
+	ErrorMessage             string        `xml:"errorMessage"`
+	Details                  DeployDetails `xml:"details"`
+}
+
+// DeployDetails lists the outcome of each component of a deployment.
+type DeployDetails struct {
+	ComponentFailures  []DeployMessage `xml:"componentFailures"`
+	ComponentSuccesses []DeployMessage `xml:"componentSuccesses"`
+}
+
+// DeployMessage is the outcome of deploying a single component.
+type DeployMessage struct {
+	ComponentType string `xml:"componentType"`
+	FullName      string `xml:"fullName"`
+	FileName      string `xml:"fileName"`
+	Success       bool   `xml:"success"`
+	Created       bool   `xml:"created"`
+	Changed       bool   `xml:"changed"`
+	Deleted       bool   `xml:"deleted"`
+	Problem       string `xml:"problem"`
+	ProblemType   string `xml:"problemType"`
+	LineNumber    int    `xml:"lineNumber"`
+	ColumnNumber  int    `xml:"columnNumber"`
+}
+
+// DeployError is the error of a deployment that finished without success.
+type DeployError struct {
+	Result *DeployResult
+}
+
+func (e *DeployError) Error() string {
+	messages := []string{}
+	if e.Result.ErrorMessage != "" {
+		messages = append(messages, e.Result.ErrorStatusCode+": "+e.Result.ErrorMessage)
+	}
+	for _, failure := range e.Result.Details.ComponentFailures {
+		messages = append(messages, fmt.Sprintf("%s %s: %s", failure.ComponentType, failure.FullName, failure.Problem))
+	}
+
+	return fmt.Sprintf("deployment %s %s: %s", e.Result.ID, strings.ToLower(e.Result.Status), strings.Join(messages, "; "))
+}
+
+// Package is the package.xml manifest of components to retrieve.
+type Package struct {
+	Types   []PackageTypeMembers `xml:"types"`
+	Version string               `xml:"version,omitempty"`
+}
+
+// PackageTypeMembers selects components of one type, "*" selects all.
+type PackageTypeMembers struct {
+	Members []string `xml:"members"`
+	Name    string   `xml:"name"`
+}
+
+// RetrieveRequest selects the components to retrieve, either by package
+// names or as an unpackaged manifest.
+type RetrieveRequest struct {
+	APIVersion    string   `xml:"apiVersion"`
+	PackageNames  []string `xml:"packageNames,omitempty"`
+	SinglePackage bool     `xml:"singlePackage"`
+	SpecificFiles []string `xml:"specificFiles,omitempty"`
+	Unpackaged    *Package `xml:"unpackaged,omitempty"`
+}
+
+// RetrieveResult is the status of a retrieval and, once done, the zip
+// package of the retrieved components.
+type RetrieveResult struct {
+	ID              string            `xml:"id"`
+	Done            bool              `xml:"done"`
+	Status          string            `xml:"status"`
+	Success         bool              `xml:"success"`
+	ErrorStatusCode string            `xml:"errorStatusCode"`
+	ErrorMessage    string            `xml:"errorMessage"`
+	FileProperties  []FileProperties  `xml:"fileProperties"`
+	Messages        []RetrieveMessage `xml:"messages"`
+	// ZipFile is the zip package, decoded from base64.
+	ZipFile []byte `xml:"-"`
+	// EncodedZipFile is the zip package as returned by the API.
+	EncodedZipFile string `xml:"zipFile"`
+}
+
+// RetrieveMessage is a warning about a component that was not retrieved.
+type RetrieveMessage struct {
+	FileName string `xml:"fileName"`
+	Problem  string `xml:"problem"`
+}
+
+// RetrieveError is the error of a retrieval that finished without success.
+type RetrieveError struct {
+	Result *RetrieveResult
+}
+
+func (e *RetrieveError) Error() string {
+	return fmt.Sprintf("retrieval %s %s: %s: %s", e.Result.ID, strings.ToLower(e.Result.Status), e.Result.ErrorStatusCode, e.Result.ErrorMessage)
+}
+
+// Deploy - Deploys a zip package and waits until the deployment is done.
+// A deployment that finishes without success returns a DeployError holding
+// the result with its component failures.
+func (c *Client) Deploy(ctx context.Context, zipFile []byte, options DeployOptions) (*DeployResult, error) {
+	request := struct {
+		XMLName       xml.Name      `xml:"deploy"`
+		ZipFile       string        `xml:"ZipFile"`
+		DeployOptions DeployOptions `xml:"DeployOptions"`
+	}{ZipFile: base64.StdEncoding.EncodeToString(zipFile), DeployOptions: options}
+
+	response := struct {
+		ID string `xml:"result>id"`
+	}{}
+	err := c.callMetadata(ctx, request, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *DeployResult
+	err = c.pollMetadata(ctx, func() (bool, error) {
+		var err error
+		result, err = c.CheckDeployStatus(ctx, response.ID)
+		return err == nil && result.Done, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !result.Success {
+		return result, &DeployError{Result: result}
+	}
+
+	return result, nil
+}
+
+// CheckDeployStatus - Returns the status of a deployment with the outcome of
+// each component.
+func (c *Client) CheckDeployStatus(ctx context.Context, id string) (*DeployResult, error) {
+	request := struct {
+		XMLName        xml.Name `xml:"checkDeployStatus"`
+		AsyncProcessID string   `xml:"asyncProcessId"`
+		IncludeDetails bool     `xml:"includeDetails"`
+	}{AsyncProcessID: id, IncludeDetails: true}
+
+	response := struct {
+		Result DeployResult `xml:"result"`
+	}{}
+	err := c.callMetadata(ctx, request, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Result, nil
+}
+
+// Retrieve - Retrieves components as zip package and waits until the
+// retrieval is done. A retrieval that finishes without success returns a
+// RetrieveError.
+func (c *Client) Retrieve(ctx context.Context, retrieveRequest RetrieveRequest) (*RetrieveResult, error) {
+	if retrieveRequest.APIVersion == "" {
+		retrieveRequest.APIVersion = c.metadataVersion()
+	}
+
+	request := struct {
+		XMLName         xml.Name        `xml:"retrieve"`
+		RetrieveRequest RetrieveRequest `xml:"retrieveRequest"`
+	}{RetrieveRequest: retrieveRequest}
+
+	response := struct {
+		ID string `xml:"result>id"`
+	}{}
+	err := c.callMetadata(ctx, request, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *RetrieveResult
+	err = c.pollMetadata(ctx, func() (bool, error) {
+		var err error
+		result, err = c.CheckRetrieveStatus(ctx, response.ID)
+		return err == nil && result.Done, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !result.Success {
+		return result, &RetrieveError{Result: result}
+	}
+
+	return result, nil
+}
+
+// CheckRetrieveStatus - Returns the status of a retrieval and, once it is
+// done, the zip package.
+func (c *Client) CheckRetrieveStatus(ctx context.Context, id string) (*RetrieveResult, error) {
+	request := struct {
+		XMLName        xml.Name `xml:"checkRetrieveStatus"`
+		AsyncProcessID string   `xml:"asyncProcessId"`
+		IncludeZip     bool     `xml:"includeZip"`
+	}{AsyncProcessID: id, IncludeZip: true}
+
+	response := struct {
+		Result RetrieveResult `xml:"result"`
+	}{}
+	err := c.callMetadata(ctx, request, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &response.Result
+	if result.EncodedZipFile != "" {
+		result.ZipFile, err = base64.StdEncoding.DecodeString(result.EncodedZipFile)
+		if err != nil {
+			return nil, fmt.Errorf("decoding retrieved zip file: %w", err)
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,175 @@
+package salesforce
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestClientDeploy(t *testing.T) {
+	tests := map[string]struct {
+		finalStatus string
+		wantErr     string
+	}{
+		"succeeded": {
+			finalStatus: `<done>true</done><id>0Af000000000001</id><status>Succeeded</status><success>true</success>` +
+				`<numberComponentsDeployed>1</numberComponentsDeployed><numberComponentsTotal>1</numberComponentsTotal>` +
+				`<details><componentSuccesses><componentType>CustomObject</componentType><fullName>vub_Training__c</fullName>` +
+				`<success>true</success><created>true</created></componentSuccesses></details>`,
+		},
+		"failed": {
+			finalStatus: `<done>true</done><id>0Af000000000001</id><status>Failed</status><success>false</success>` +
+				`<numberComponentErrors>1</numberComponentErrors>` +
+				`<details><componentFailures><componentType>CustomField</componentType><fullName>vub_Training__c.vub_Level__c</fullName>` +
+				`<problem>Picklist values are missing</problem><problemType>Error</problemType><success>false</success></componentFailures></details>`,
+			wantErr: "deployment 0Af000000000001 failed: CustomField vub_Training__c.vub_Level__c: Picklist values are missing",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var operations []string
+			var zipFile string
+			client := newMetadataTestClient(t, &Token{AccessToken: "token"}, nil, func(call soapCall) (int, string) {
+				operations = append(operations, call.Operation)
+				switch call.Operation {
+				case "deploy":
+					request := struct {
+						ZipFile   string `xml:"ZipFile"`
+						CheckOnly bool   `xml:"DeployOptions>checkOnly"`
+					}{}
+					_ = xml.Unmarshal(call.Body, &request)
+					zipFile = request.ZipFile
+					if !request.CheckOnly {
+						t.Error("deploy options were not sent")
+					}
+					return http.StatusOK, `<result><done>false</done><id>0Af000000000001</id><state>Queued</state></result>`
+				case "checkDeployStatus":
+					if !strings.Contains(string(call.Body), "0Af000000000001") {
+						t.Errorf("status check of another deployment: %s", call.Body)
+					}
+					if len(operations) < 4 {
+						return http.StatusOK, `<result><done>false</done><id>0Af000000000001</id><status>InProgress</status></result>`
+					}
+					return http.StatusOK, `<result>` + test.finalStatus + `</result>`
+				}
+				t.Errorf("unexpected operation %s", call.Operation)
+				return http.StatusInternalServerError, soapFault("INVALID_OPERATION", call.Operation)
+			})
+
+			result, err := client.Deploy(context.Background(), []byte("zip"), DeployOptions{CheckOnly: true})
+
+			if zipFile != base64.StdEncoding.EncodeToString([]byte("zip")) {
+				t.Errorf("ZipFile = %q, want the encoded package", zipFile)
+			}
+			if want := "deploy,checkDeployStatus,checkDeployStatus,checkDeployStatus"; strings.Join(operations, ",") != want {
+				t.Errorf("operations = %v, want %s", operations, want)
+			}
+
+			if test.wantErr != "" {
+				var deployErr *DeployError
+				if !errors.As(err, &deployErr) || err.Error() != test.wantErr {
+					t.Fatalf("Deploy() error = %v, want %q", err, test.wantErr)
+				}
+				if result == nil || len(result.Details.ComponentFailures) != 1 {
+					t.Errorf("Deploy() result = %+v, want the component failure", result)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Deploy() error = %v", err)
+			}
+			if !result.Success || result.NumberComponentsDeployed != 1 || len(result.Details.ComponentSuccesses) != 1 {
+				t.Errorf("Deploy() = %+v", result)
+			}
+		})
+	}
+}
+
+func TestClientRetrieve(t *testing.T) {
+	tests := map[string]struct {
+		finalStatus string
+		wantErr     string
+	}{
+		"succeeded": {
+			finalStatus: `<done>true</done><id>09S000000000001</id><status>Succeeded</status><success>true</success>` +
+				`<fileProperties><fileName>objects/vub_Training__c.object</fileName><fullName>vub_Training__c</fullName><type>CustomObject</type></fileProperties>` +
+				`<zipFile>` + base64.StdEncoding.EncodeToString([]byte("zip")) + `</zipFile>`,
+		},
+		"failed": {
+			finalStatus: `<done>true</done><id>09S000000000001</id><status>Failed</status><success>false</success>` +
+				`<errorStatusCode>INVALID_CROSS_REFERENCE_KEY</errorStatusCode><errorMessage>No package named Missing</errorMessage>`,
+			wantErr: "retrieval 09S000000000001 failed: INVALID_CROSS_REFERENCE_KEY: No package named Missing",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var apiVersion string
+			checks := 0
+			client := newMetadataTestClient(t, &Token{AccessToken: "token"}, nil, func(call soapCall) (int, string) {
+				switch call.Operation {
+				case "retrieve":
+					request := struct {
+						APIVersion string `xml:"retrieveRequest>apiVersion"`
+					}{}
+					_ = xml.Unmarshal(call.Body, &request)
+					apiVersion = request.APIVersion
+					return http.StatusOK, `<result><done>false</done><id>09S000000000001</id><state>Queued</state></result>`
+				case "checkRetrieveStatus":
+					checks++
+					if checks < 2 {
+						return http.StatusOK, `<result><done>false</done><id>09S000000000001</id><status>InProgress</status></result>`
+					}
+					return http.StatusOK, `<result>` + test.finalStatus + `</result>`
+				}
+				t.Errorf("unexpected operation %s", call.Operation)
+				return http.StatusInternalServerError, soapFault("INVALID_OPERATION", call.Operation)
+			})
+
+			result, err := client.Retrieve(context.Background(), RetrieveRequest{
+				Unpackaged: &Package{Types: []PackageTypeMembers{{Name: "CustomObject", Members: []string{"vub_Training__c"}}}},
+			})
+
+			if apiVersion != "59.0" {
+				t.Errorf("apiVersion = %q, want the version of the client", apiVersion)
+			}
+			if checks != 2 {
+				t.Errorf("status checked %d times, want 2", checks)
+			}
+
+			if test.wantErr != "" {
+				var retrieveErr *RetrieveError
+				if !errors.As(err, &retrieveErr) || err.Error() != test.wantErr {
+					t.Fatalf("Retrieve() error = %v, want %q", err, test.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Retrieve() error = %v", err)
+			}
+			if string(result.ZipFile) != "zip" || len(result.FileProperties) != 1 {
+				t.Errorf("Retrieve() = %+v", result)
+			}
+		})
+	}
+}
+
+func TestClientDeployCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	client := newMetadataTestClient(t, &Token{AccessToken: "token"}, nil, func(call soapCall) (int, string) {
+		if call.Operation == "checkDeployStatus" {
+			cancel()
+		}
+		return http.StatusOK, `<result><done>false</done><id>0Af000000000001</id></result>`
+	})
+
+	_, err := client.Deploy(ctx, []byte("zip"), DeployOptions{})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Deploy() error = %v, want context.Canceled", err)
+	}
+}
@@ -0,0 +1,360 @@
+package salesforce
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// soapCall is a Metadata API call received by a test server.
+type soapCall struct {
+	SessionID string
+	// Operation is the name of the element in the body, e.g.
+	// "createMetadata".
+	Operation string
+	// Body is the XML of the operation element.
+	Body []byte
+}
+
+// soapHandler answers a Metadata API call with a status code and the content
+// of the response body, wrapped in an envelope unless it is a fault.
+type soapHandler func(call soapCall) (int, string)
+
+// newMetadataTestClient returns a client whose Metadata API calls are
+// answered by handler.
+func newMetadataTestClient(t *testing.T, token *Token, tokenSource TokenSource, handler soapHandler) *Client {
+	t.Helper()
+
+	return newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/services/Soap/m/59.0" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if r.Header.Get("SOAPAction") != `""` || !strings.HasPrefix(r.Header.Get("Content-Type"), "text/xml") {
+			t.Errorf("unexpected SOAP headers %v", r.Header)
+		}
+
+		call, err := parseSOAPCall(r.Body)
+		if err != nil {
+			t.Errorf("parsing SOAP request: %v", err)
+		}
+
+		statusCode, content := handler(call)
+		w.Header().Set("Content-Type", "text/xml;charset=UTF-8")
+		w.WriteHeader(statusCode)
+		if statusCode == http.StatusOK {
+			content = soapResponse(`<` + call.Operation + `Response>` + content + `</` + call.Operation + `Response>`)
+		}
+		_, _ = io.WriteString(w, content)
+	}), token, tokenSource)
+}
+
+func parseSOAPCall(body io.Reader) (soapCall, error) {
+	envelope := struct {
+		SessionID string `xml:"Header>SessionHeader>sessionId"`
+		Body      struct {
+			Content []byte `xml:",innerxml"`
+		} `xml:"Body"`
+	}{}
+	err := xml.NewDecoder(body).Decode(&envelope)
+	if err != nil {
+		return soapCall{}, err
+	}
+
+	call := soapCall{SessionID: envelope.SessionID, Body: envelope.Body.Content}
+	decoder := xml.NewDecoder(bytes.NewReader(envelope.Body.Content))
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return call, err
+		}
+		if start, ok := token.(xml.StartElement); ok {
+			call.Operation = start.Name.Local
+			return call, nil
+		}
+	}
+}
+
+func soapResponse(content string) string {
+	return xml.Header + `<soapenv:Envelope xmlns:soapenv="http://schemas.xmlsoap.org/soap/envelope/" ` +
+		`xmlns="http://soap.sforce.com/2006/04/metadata" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance">` +
+		`<soapenv:Body>` + content + `</soapenv:Body></soapenv:Envelope>`
+}
+
+func soapFault(code, message string) string {
+	return soapResponse(`<soapenv:Fault><faultcode>sf:` + code + `</faultcode><faultstring>` + message + `</faultstring></soapenv:Fault>`)
+}
+
+func TestClientCreateMetadataEnvelope(t *testing.T) {
+	var received soapCall
+	client := newMetadataTestClient(t, &Token{AccessToken: "00D!a&b<c"}, nil, func(call soapCall) (int, string) {
+		received = call
+		return http.StatusOK, `<result><fullName>vub_Training__c</fullName><success>true</success></result>` +
+			`<result><fullName>vub_Training__c.vub_Duration__c</fullName><success>true</success></result>`
+	})
+
+	results, err := client.CreateMetadata(context.Background(), []Metadata{
+		CustomObject{FullName: "vub_Training__c", Label: "Training"},
+		CustomField{FullName: "vub_Training__c.vub_Duration__c", Label: "Duration", Type: CustomFieldTypeNumber},
+	})
+	if err != nil {
+		t.Fatalf("CreateMetadata() error = %v", err)
+	}
+	if len(results) != 2 || results[0].Err() != nil || results[1].FullName != "vub_Training__c.vub_Duration__c" {
+		t.Errorf("CreateMetadata() = %+v", results)
+	}
+
+	if received.SessionID != "00D!a&b<c" {
+		t.Errorf("session = %q, want the escaped access token", received.SessionID)
+	}
+	if received.Operation != "createMetadata" {
+		t.Errorf("operation = %q, want createMetadata", received.Operation)
+	}
+
+	// The xsi:type of every component resolves without the envelope.
+	request := struct {
+		Metadata []struct {
+			Attrs    []xml.Attr `xml:",any,attr"`
+			FullName string     `xml:"fullName"`
+		} `xml:"metadata"`
+	}{}
+	err = xml.Unmarshal(received.Body, &request)
+	if err != nil {
+		t.Fatalf("decoding request: %v", err)
+	}
+	types := []string{}
+	for _, metadata := range request.Metadata {
+		for _, attr := range metadata.Attrs {
+			if attr.Name.Space == xsiNamespace && attr.Name.Local == "type" {
+				types = append(types, attr.Value)
+			}
+		}
+	}
+	if want := []string{"CustomObject", "CustomField"}; !reflect.DeepEqual(types, want) {
+		t.Errorf("xsi:type = %v, want %v", types, want)
+	}
+}
+
+func TestClientMetadataFault(t *testing.T) {
+	client := newMetadataTestClient(t, &Token{AccessToken: "token"}, nil, func(call soapCall) (int, string) {
+		return http.StatusInternalServerError, soapFault("INVALID_TYPE", "This type of object is not available for this organization")
+	})
+
+	_, err := client.ListMetadata(context.Background(), []ListMetadataQuery{{Type: "Unknown"}})
+
+	var fault *SOAPFault
+	if !errors.As(err, &fault) {
+		t.Fatalf("ListMetadata() error = %v, want SOAPFault", err)
+	}
+	if fault.StatusCode != http.StatusInternalServerError || fault.Code != "INVALID_TYPE" {
+		t.Errorf("SOAPFault = %+v", fault)
+	}
+}
+
+func TestClientMetadataRefreshesSession(t *testing.T) {
+	var sessions []string
+	tokenSource := tokenSourceFunc(func(ctx context.Context) (*Token, error) {
+		return &Token{AccessToken: "fresh"}, nil
+	})
+	client := newMetadataTestClient(t, &Token{AccessToken: "stale"}, tokenSource, func(call soapCall) (int, string) {
+		sessions = append(sessions, call.SessionID)
+		if call.SessionID != "fresh" {
+			return http.StatusInternalServerError, soapFault("INVALID_SESSION_ID", "Invalid Session ID found in SessionHeader")
+		}
+		return http.StatusOK, `<result><fullName>Account</fullName><type>CustomObject</type></result>`
+	})
+
+	properties, err := client.ListMetadata(context.Background(), []ListMetadataQuery{{Type: "CustomObject"}})
+	if err != nil {
+		t.Fatalf("ListMetadata() error = %v", err)
+	}
+	if len(properties) != 1 || properties[0].FullName != "Account" {
+		t.Errorf("ListMetadata() = %+v", properties)
+	}
+	// The envelope is built again with the new session
+	if want := []string{"stale", "fresh"}; !reflect.DeepEqual(sessions, want) {
+		t.Errorf("sessions = %v, want %v", sessions, want)
+	}
+}
+
+func TestClientSaveMetadataErrors(t *testing.T) {
+	client := newMetadataTestClient(t, &Token{AccessToken: "token"}, nil, func(call soapCall) (int, string) {
+		return http.StatusOK, `<result><errors><fields>label</fields><message>Label is required</message>` +
+			`<statusCode>REQUIRED_FIELD_MISSING</statusCode></errors>` +
+			`<errors><message>Duplicate</message><statusCode>DUPLICATE_DEVELOPER_NAME</statusCode></errors>` +
+			`<fullName>vub_Training__c</fullName><success>false</success></result>`
+	})
+
+	for _, create := range []bool{true, false} {
+		err := client.SaveMetadata(context.Background(), CustomObject{FullName: "vub_Training__c"}, create)
+
+		var resultErr *MetadataResultError
+		if !errors.As(err, &resultErr) {
+			t.Fatalf("SaveMetadata() error = %v, want MetadataResultError", err)
+		}
+		if !resultErr.HasStatusCode("DUPLICATE_DEVELOPER_NAME") || resultErr.HasStatusCode("INVALID_TYPE") {
+			t.Errorf("status codes of %+v", resultErr)
+		}
+		want := "vub_Training__c: REQUIRED_FIELD_MISSING: Label is required (fields: label); DUPLICATE_DEVELOPER_NAME: Duplicate"
+		if err.Error() != want {
+			t.Errorf("Error() = %q, want %q", err.Error(), want)
+		}
+	}
+
+	err := client.DeleteComponent(context.Background(), "CustomObject", "vub_Training__c")
+	if err == nil {
+		t.Error("DeleteComponent() succeeded with a failed result")
+	}
+}
+
+func TestClientDeleteComponentNotFound(t *testing.T) {
+	client := newMetadataTestClient(t, &Token{AccessToken: "token"}, nil, func(call soapCall) (int, string) {
+		return http.StatusOK, `<result><errors><message>In field: fullName - no CustomObject named vub_Training__c found</message>` +
+			`<statusCode>INVALID_CROSS_REFERENCE_KEY</statusCode></errors><fullName>vub_Training__c</fullName><success>false</success></result>`
+	})
+
+	err := client.DeleteComponent(context.Background(), "CustomObject", "vub_Training__c")
+	if !IsComponentNotFound(err) {
+		t.Errorf("DeleteComponent() error = %v, want a missing component", err)
+	}
+}
+
+func TestClientSaveMetadataResultCount(t *testing.T) {
+	client := newMetadataTestClient(t, &Token{AccessToken: "token"}, nil, func(call soapCall) (int, string) {
+		return http.StatusOK, ``
+	})
+
+	err := client.SaveMetadata(context.Background(), CustomObject{FullName: "vub_Training__c"}, true)
+	if err == nil || !strings.Contains(err.Error(), "expected 1 result") {
+		t.Errorf("SaveMetadata() error = %v", err)
+	}
+}
+
+func TestClientReadMetadata(t *testing.T) {
+	records := map[string]string{
+		"CustomObject": `<records xsi:type="CustomObject"><fullName>vub_Training__c</fullName>` +
+			`<deploymentStatus>Deployed</deploymentStatus><enableReports>true</enableReports><label>Training</label>` +
+			`<nameField><displayFormat>T-{0000}</displayFormat><label>Training Number</label><trackHistory>false</trackHistory><type>AutoNumber</type></nameField>` +
+			`<pluralLabel>Trainings</pluralLabel><sharingModel>ReadWrite</sharingModel></records>`,
+		"CustomField": `<records xsi:type="CustomField"><fullName>vub_Training__c.vub_Level__c</fullName>` +
+			`<label>Level</label><required>false</required><type>Picklist</type>` +
+			`<valueSet><restricted>true</restricted><valueSetDefinition><sorted>false</sorted>` +
+			`<value><fullName>Beginner</fullName><default>true</default><label>Beginner</label></value>` +
+			`<value><fullName>Expert</fullName><default>false</default><isActive>false</isActive><label>Expert</label></value>` +
+			`</valueSetDefinition></valueSet></records>`,
+		"PermissionSet": `<records xsi:type="PermissionSet"><fullName>vub_Trainer</fullName>` +
+			`<description>Manages trainings</description><hasActivationRequired>false</hasActivationRequired><label>Trainer</label>` +
+			`<objectPermissions><allowCreate>true</allowCreate><allowDelete>false</allowDelete><allowEdit>true</allowEdit>` +
+			`<allowRead>true</allowRead><modifyAllRecords>false</modifyAllRecords><object>vub_Training__c</object><viewAllRecords>false</viewAllRecords></objectPermissions>` +
+			`<userPermissions><enabled>true</enabled><name>ApiEnabled</name></userPermissions></records>`,
+	}
+	var requested []string
+	client := newMetadataTestClient(t, &Token{AccessToken: "token"}, nil, func(call soapCall) (int, string) {
+		request := struct {
+			Type      string   `xml:"type"`
+			FullNames []string `xml:"fullNames"`
+		}{}
+		_ = xml.Unmarshal(call.Body, &request)
+		requested = append(requested, request.Type+":"+strings.Join(request.FullNames, ","))
+
+		if strings.HasPrefix(request.FullNames[0], "Missing") {
+			return http.StatusOK, `<result><records xsi:nil="true"/></result>`
+		}
+		return http.StatusOK, `<result>` + records[request.Type] + `</result>`
+	})
+	ctx := context.Background()
+
+	object, err := client.GetCustomObject(ctx, "vub_Training__c")
+	if err != nil {
+		t.Fatalf("GetCustomObject() error = %v", err)
+	}
+	wantObject := &CustomObject{
+		FullName:         "vub_Training__c",
+		DeploymentStatus: DeploymentStatusDeployed,
+		EnableReports:    true,
+		Label:            "Training",
+		NameField:        &CustomObjectNameField{DisplayFormat: "T-{0000}", Label: "Training Number", Type: "AutoNumber"},
+		PluralLabel:      "Trainings",
+		SharingModel:     SharingModelReadWrite,
+	}
+	if !reflect.DeepEqual(object, wantObject) {
+		t.Errorf("GetCustomObject() = %+v, want %+v", object, wantObject)
+	}
+
+	field, err := client.GetCustomField(ctx, "vub_Training__c.vub_Level__c")
+	if err != nil {
+		t.Fatalf("GetCustomField() error = %v", err)
+	}
+	inactive := false
+	wantField := &CustomField{
+		FullName: "vub_Training__c.vub_Level__c",
+		Label:    "Level",
+		Type:     CustomFieldTypePicklist,
+		ValueSet: &ValueSet{
+			Restricted: true,
+			ValueSetDefinition: &ValueSetDefinition{Values: []CustomValue{
+				{FullName: "Beginner", Default: true, Label: "Beginner"},
+				{FullName: "Expert", IsActive: &inactive, Label: "Expert"},
+			}},
+		},
+	}
+	if !reflect.DeepEqual(field, wantField) {
+		t.Errorf("GetCustomField() = %+v, want %+v", field, wantField)
+	}
+
+	permissionSet, err := client.GetPermissionSet(ctx, "vub_Trainer")
+	if err != nil {
+		t.Fatalf("GetPermissionSet() error = %v", err)
+	}
+	wantPermissionSet := &PermissionSetMetadata{
+		FullName:    "vub_Trainer",
+		Description: "Manages trainings",
+		Label:       "Trainer",
+		ObjectPermissions: []PermissionSetObjectPermissions{
+			{AllowCreate: true, AllowEdit: true, AllowRead: true, Object: "vub_Training__c"},
+		},
+		UserPermissions: []PermissionSetUserPermission{{Enabled: true, Name: "ApiEnabled"}},
+	}
+	if !reflect.DeepEqual(permissionSet, wantPermissionSet) {
+		t.Errorf("GetPermissionSet() = %+v, want %+v", permissionSet, wantPermissionSet)
+	}
+
+	_, err = client.GetCustomObject(ctx, "Missing__c")
+	if !IsNotFound(err) {
+		t.Errorf("GetCustomObject() error = %v, want not found", err)
+	}
+	_, err = client.GetCustomField(ctx, "Missing__c.Field__c")
+	if !IsNotFound(err) {
+		t.Errorf("GetCustomField() error = %v, want not found", err)
+	}
+	_, err = client.GetPermissionSet(ctx, "Missing")
+	if !IsNotFound(err) {
+		t.Errorf("GetPermissionSet() error = %v, want not found", err)
+	}
+
+	want := []string{
+		"CustomObject:vub_Training__c",
+		"CustomField:vub_Training__c.vub_Level__c",
+		"PermissionSet:vub_Trainer",
+		"CustomObject:Missing__c",
+		"CustomField:Missing__c.Field__c",
+		"PermissionSet:Missing",
+	}
+	if !reflect.DeepEqual(requested, want) {
+		t.Errorf("requested %v, want %v", requested, want)
+	}
+}
+
+func TestClientReadMetadataRecords(t *testing.T) {
+	client := &Client{}
+
+	err := client.ReadMetadata(context.Background(), "CustomObject", []string{"vub_Training__c"}, []CustomObject{})
+	if err == nil {
+		t.Error("ReadMetadata() accepted a slice instead of a pointer to a slice")
+	}
+}
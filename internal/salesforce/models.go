@@ -1,12 +1,130 @@
 package salesforce
 
+import "encoding/json"
+
 type Description struct {
-	Name   string             `json:"name"`
-	Label  string             `json:"label"`
-	Fields []DescriptionField `json:"fields"`
+	Name               string              `json:"name"`
+	Label              string              `json:"label"`
+	LabelPlural        string              `json:"labelPlural"`
+	KeyPrefix          *string             `json:"keyPrefix"`
+	Custom             bool                `json:"custom"`
+	CustomSetting      bool                `json:"customSetting"`
+	Createable         bool                `json:"createable"`
+	Updateable         bool                `json:"updateable"`
+	Deletable          bool                `json:"deletable"`
+	Undeletable        bool                `json:"undeletable"`
+	Queryable          bool                `json:"queryable"`
+	Retrieveable       bool                `json:"retrieveable"`
+	Searchable         bool                `json:"searchable"`
+	Mergeable          bool                `json:"mergeable"`
+	Triggerable        bool                `json:"triggerable"`
+	FeedEnabled        bool                `json:"feedEnabled"`
+	Fields             []DescriptionField  `json:"fields"`
+	ChildRelationships []ChildRelationship `json:"childRelationships"`
+	RecordTypeInfos    []RecordTypeInfo    `json:"recordTypeInfos"`
 }
 type DescriptionField struct {
-	Name  string `json:"name"`
-	Label string `json:"label"`
-	Type  string `json:"type"`
+	Name                         string          `json:"name"`
+	Label                        string          `json:"label"`
+	Type                         string          `json:"type"`
+	Length                       int             `json:"length"`
+	ByteLength                   int             `json:"byteLength"`
+	Precision                    int             `json:"precision"`
+	Scale                        int             `json:"scale"`
+	Digits                       int             `json:"digits"`
+	SoapType                     string          `json:"soapType"`
+	Nillable                     bool            `json:"nillable"`
+	Unique                       bool            `json:"unique"`
+	CaseSensitive                bool            `json:"caseSensitive"`
+	ExternalID                   bool            `json:"externalId"`
+	IDLookup                     bool            `json:"idLookup"`
+	NameField                    bool            `json:"nameField"`
+	Custom                       bool            `json:"custom"`
+	Createable                   bool            `json:"createable"`
+	Updateable                   bool            `json:"updateable"`
+	DefaultedOnCreate            bool            `json:"defaultedOnCreate"`
+	Filterable                   bool            `json:"filterable"`
+	Sortable                     bool            `json:"sortable"`
+	Groupable                    bool            `json:"groupable"`
+	Aggregatable                 bool            `json:"aggregatable"`
+	Permissionable               bool            `json:"permissionable"`
+	Encrypted                    bool            `json:"encrypted"`
+	HTMLFormatted                bool            `json:"htmlFormatted"`
+	AutoNumber                   bool            `json:"autoNumber"`
+	Calculated                   bool            `json:"calculated"`
+	CalculatedFormula            *string         `json:"calculatedFormula"`
+	FormulaTreatNullNumberAsZero bool            `json:"formulaTreatNullNumberAsZero"`
+	HighScaleNumber              bool            `json:"highScaleNumber"`
+	DefaultValue                 json.RawMessage `json:"defaultValue"`
+	DefaultValueFormula          *string         `json:"defaultValueFormula"`
+	InlineHelpText               *string         `json:"inlineHelpText"`
+	ReferenceTo                  []string        `json:"referenceTo"`
+	RelationshipName             *string         `json:"relationshipName"`
+	RelationshipOrder            *int            `json:"relationshipOrder"`
+	ReferenceTargetField         *string         `json:"referenceTargetField"`
+	PolymorphicForeignKey        bool            `json:"polymorphicForeignKey"`
+	CascadeDelete                bool            `json:"cascadeDelete"`
+	RestrictedDelete             bool            `json:"restrictedDelete"`
+	WriteRequiresMasterRead      bool            `json:"writeRequiresMasterRead"`
+	CompoundFieldName            *string         `json:"compoundFieldName"`
+	ExtraTypeInfo                *string         `json:"extraTypeInfo"`
+	Mask                         *string         `json:"mask"`
+	MaskType                     *string         `json:"maskType"`
+	DeprecatedAndHidden          bool            `json:"deprecatedAndHidden"`
+	PicklistValues               []PicklistValue `json:"picklistValues"`
+	ControllerName               *string         `json:"controllerName"`
+	DependentPicklist            bool            `json:"dependentPicklist"`
+	RestrictedPicklist           bool            `json:"restrictedPicklist"`
+}
+type ChildRelationship struct {
+	ChildSObject        string  `json:"childSObject"`
+	Field               string  `json:"field"`
+	RelationshipName    *string `json:"relationshipName"`
+	CascadeDelete       bool    `json:"cascadeDelete"`
+	RestrictedDelete    bool    `json:"restrictedDelete"`
+	DeprecatedAndHidden bool    `json:"deprecatedAndHidden"`
+}
+type RecordTypeInfo struct {
+	Name                     string `json:"name"`
+	DeveloperName            string `json:"developerName"`
+	RecordTypeID             string `json:"recordTypeId"`
+	Active                   bool   `json:"active"`
+	Available                bool   `json:"available"`
+	DefaultRecordTypeMapping bool   `json:"defaultRecordTypeMapping"`
+	Master                   bool   `json:"master"`
+}
+type PicklistValue struct {
+	Value        string  `json:"value"`
+	Label        *string `json:"label"`
+	Active       bool    `json:"active"`
+	DefaultValue bool    `json:"defaultValue"`
+	ValidFor     *string `json:"validFor"`
+}
+
+type GlobalDescription struct {
+	Encoding     string               `json:"encoding"`
+	MaxBatchSize int                  `json:"maxBatchSize"`
+	SObjects     []SObjectDescription `json:"sobjects"`
+}
+type SObjectDescription struct {
+	Name        string `json:"name"`
+	Label       string `json:"label"`
+	LabelPlural string `json:"labelPlural"`
+	KeyPrefix   string `json:"keyPrefix"`
+	Custom      bool   `json:"custom"`
+	Queryable   bool   `json:"queryable"`
+	Createable  bool   `json:"createable"`
+	Updateable  bool   `json:"updateable"`
+	Deletable   bool   `json:"deletable"`
 }
+
+type QueryResult struct {
+	TotalSize      int               `json:"totalSize"`
+	Done           bool              `json:"done"`
+	NextRecordsURL string            `json:"nextRecordsUrl"`
+	Records        []json.RawMessage `json:"records"`
+}
+
+// Record is an sObject record by field name. The attributes entry holds the
+// type and URL of the record.
+type Record map[string]json.RawMessage
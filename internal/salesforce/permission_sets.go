@@ -0,0 +1,113 @@
+package salesforce
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// Visibilities of tabs in permission sets.
+const (
+	TabVisibilityAvailable = "Available"
+	TabVisibilityNone      = "None"
+	TabVisibilityVisible   = "Visible"
+)
+
+// PermissionSetMetadata is the Metadata API component of a permission set.
+// Elements are in the order of the Metadata API WSDL.
+type PermissionSetMetadata struct {
+	FullName               string                              `xml:"fullName"`
+	ClassAccesses          []ApexClassAccess                   `xml:"classAccesses,omitempty"`
+	CustomPermissions      []PermissionSetCustomPermission     `xml:"customPermissions,omitempty"`
+	Description            string                              `xml:"description,omitempty"`
+	FieldPermissions       []PermissionSetFieldPermissions     `xml:"fieldPermissions,omitempty"`
+	HasActivationRequired  bool                                `xml:"hasActivationRequired,omitempty"`
+	Label                  string                              `xml:"label"`
+	License                string                              `xml:"license,omitempty"`
+	ObjectPermissions      []PermissionSetObjectPermissions    `xml:"objectPermissions,omitempty"`
+	PageAccesses           []ApexPageAccess                    `xml:"pageAccesses,omitempty"`
+	RecordTypeVisibilities []PermissionSetRecordTypeVisibility `xml:"recordTypeVisibilities,omitempty"`
+	TabSettings            []PermissionSetTabSetting           `xml:"tabSettings,omitempty"`
+	UserPermissions        []PermissionSetUserPermission       `xml:"userPermissions,omitempty"`
+}
+
+// MetadataType returns the Metadata API type of permission sets.
+func (PermissionSetMetadata) MetadataType() string {
+	return "PermissionSet"
+}
+
+// ApexClassAccess is the access to an Apex class.
+type ApexClassAccess struct {
+	ApexClass string `xml:"apexClass"`
+	Enabled   bool   `xml:"enabled"`
+}
+
+// ApexPageAccess is the access to a Visualforce page.
+type ApexPageAccess struct {
+	ApexPage string `xml:"apexPage"`
+	Enabled  bool   `xml:"enabled"`
+}
+
+// PermissionSetCustomPermission is the access to a custom permission.
+type PermissionSetCustomPermission struct {
+	Enabled bool   `xml:"enabled"`
+	Name    string `xml:"name"`
+}
+
+// PermissionSetFieldPermissions is the access to a field, e.g.
+// "Account.vub_Region__c".
+type PermissionSetFieldPermissions struct {
+	Editable bool   `xml:"editable"`
+	Field    string `xml:"field"`
+	Readable bool   `xml:"readable"`
+}
+
+// PermissionSetObjectPermissions is the access to the records of an object.
+type PermissionSetObjectPermissions struct {
+	AllowCreate      bool   `xml:"allowCreate"`
+	AllowDelete      bool   `xml:"allowDelete"`
+	AllowEdit        bool   `xml:"allowEdit"`
+	AllowRead        bool   `xml:"allowRead"`
+	ModifyAllRecords bool   `xml:"modifyAllRecords"`
+	Object           string `xml:"object"`
+	ViewAllRecords   bool   `xml:"viewAllRecords"`
+}
+
+// PermissionSetRecordTypeVisibility is the access to a record type, e.g.
+// "Account.Business".
+type PermissionSetRecordTypeVisibility struct {
+	RecordType string `xml:"recordType"`
+	Visible    bool   `xml:"visible"`
+}
+
+// PermissionSetTabSetting is the visibility of a tab, one of the
+// TabVisibility constants.
+type PermissionSetTabSetting struct {
+	Tab        string `xml:"tab"`
+	Visibility string `xml:"visibility"`
+}
+
+// PermissionSetUserPermission is a system permission such as "ApiEnabled".
+type PermissionSetUserPermission struct {
+	Enabled bool   `xml:"enabled"`
+	Name    string `xml:"name"`
+}
+
+// GetPermissionSet - Returns the permission set with fullName, or an
+// APIError matching IsNotFound if it does not exist.
+func (c *Client) GetPermissionSet(ctx context.Context, fullName string) (*PermissionSetMetadata, error) {
+	var permissionSets []PermissionSetMetadata
+	err := c.ReadMetadata(ctx, "PermissionSet", []string{fullName}, &permissionSets)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(permissionSets) == 0 {
+		return nil, &APIError{
+			StatusCode: http.StatusNotFound,
+			Errors:     []ErrorDetail{{ErrorCode: ErrorCodeNotFound, Message: fmt.Sprintf("permission set %s does not exist", fullName)}},
+		}
+	}
+
+	return &permissionSets[0], nil
+}
@@ -0,0 +1,93 @@
+package salesforce
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+)
+
+// Field returns the field named name, matched case-insensitively like
+// Salesforce matches API names.
+func (d *Description) Field(name string) (*DescriptionField, bool) {
+	for i := range d.Fields {
+		if strings.EqualFold(d.Fields[i].Name, name) {
+			return &d.Fields[i], true
+		}
+	}
+
+	return nil, false
+}
+
+// ControllingValues returns the values of the field controlling the
+// dependent picklist field, in the order the validFor bitmaps refer to them.
+func (d *Description) ControllingValues(field DescriptionField) ([]string, error) {
+	if field.ControllerName == nil {
+		return nil, fmt.Errorf("field %s is not a dependent picklist", field.Name)
+	}
+
+	controller, ok := d.Field(*field.ControllerName)
+	if !ok {
+		return nil, fmt.Errorf("controlling field %s of %s not found", *field.ControllerName, field.Name)
+	}
+
+	// A checkbox controls dependent picklists with its two states.
+	if controller.Type == "boolean" {
+		return []string{"false", "true"}, nil
+	}
+
+	values := make([]string, 0, len(controller.PicklistValues))
+	for _, value := range controller.PicklistValues {
+		values = append(values, value.Value)
+	}
+
+	return values, nil
+}
+
+// ValidForValues returns the controlling values a dependent picklist value is
+// valid for. controllingValues are the values returned by ControllingValues.
+func (v PicklistValue) ValidForValues(controllingValues []string) ([]string, error) {
+	if v.ValidFor == nil {
+		return nil, nil
+	}
+
+	// validFor is a bitmap with one bit per controlling value, the most
+	// significant bit of the first byte standing for the first value.
+	bitmap, err := base64.StdEncoding.DecodeString(*v.ValidFor)
+	if err != nil {
+		return nil, fmt.Errorf("decoding validFor of picklist value %s: %w", v.Value, err)
+	}
+
+	var values []string
+	for i, controllingValue := range controllingValues {
+		if i/8 < len(bitmap) && bitmap[i/8]&(0x80>>(i%8)) != 0 {
+			values = append(values, controllingValue)
+		}
+	}
+
+	return values, nil
+}
+
+// DependentValues maps every value of the controlling field of a dependent
+// picklist field to the values of field that are valid for it.
+func (d *Description) DependentValues(field DescriptionField) (map[string][]string, error) {
+	controllingValues, err := d.ControllingValues(field)
+	if err != nil {
+		return nil, err
+	}
+
+	dependentValues := make(map[string][]string, len(controllingValues))
+	for _, controllingValue := range controllingValues {
+		dependentValues[controllingValue] = []string{}
+	}
+	for _, value := range field.PicklistValues {
+		validFor, err := value.ValidForValues(controllingValues)
+		if err != nil {
+			return nil, err
+		}
+		for _, controllingValue := range validFor {
+			dependentValues[controllingValue] = append(dependentValues[controllingValue], value.Value)
+		}
+	}
+
+	return dependentValues, nil
+}
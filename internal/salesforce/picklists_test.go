@@ -0,0 +1,117 @@
+package salesforce
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPicklistValueValidForValues(t *testing.T) {
+	controllingValues := []string{"A", "B", "C", "D", "E", "F", "G", "H", "I"}
+
+	tests := map[string]struct {
+		validFor *string
+		want     []string
+		wantErr  bool
+	}{
+		"independent": {},
+		"first": {
+			validFor: stringPointer("gA=="),
+			want:     []string{"A"},
+		},
+		"across bytes": {
+			validFor: stringPointer("oIA="),
+			want:     []string{"A", "C", "I"},
+		},
+		"none": {
+			validFor: stringPointer("AAA="),
+		},
+		"short bitmap": {
+			validFor: stringPointer("QA=="),
+			want:     []string{"B"},
+		},
+		"invalid": {
+			validFor: stringPointer("not base64!"),
+			wantErr:  true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			value := PicklistValue{Value: "dependent", ValidFor: test.validFor}
+			got, err := value.ValidForValues(controllingValues)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("ValidForValues() error = %v, wantErr %t", err, test.wantErr)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ValidForValues() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestDescriptionDependentValues(t *testing.T) {
+	description := &Description{
+		Fields: []DescriptionField{
+			{
+				Name: "Region__c",
+				Type: "picklist",
+				PicklistValues: []PicklistValue{
+					{Value: "EMEA"},
+					{Value: "APAC"},
+				},
+			},
+			{
+				Name:           "Country__c",
+				Type:           "picklist",
+				ControllerName: stringPointer("region__c"),
+				PicklistValues: []PicklistValue{
+					{Value: "DE", ValidFor: stringPointer("gA==")},
+					{Value: "JP", ValidFor: stringPointer("QA==")},
+					{Value: "US", ValidFor: stringPointer("AA==")},
+				},
+			},
+			{
+				Name:           "Reason__c",
+				Type:           "picklist",
+				ControllerName: stringPointer("IsActive__c"),
+				PicklistValues: []PicklistValue{
+					{Value: "Churned", ValidFor: stringPointer("gA==")},
+				},
+			},
+			{
+				Name: "IsActive__c",
+				Type: "boolean",
+			},
+		},
+	}
+
+	country, _ := description.Field("country__c")
+	got, err := description.DependentValues(*country)
+	if err != nil {
+		t.Fatalf("DependentValues() error = %v", err)
+	}
+	want := map[string][]string{"EMEA": {"DE"}, "APAC": {"JP"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DependentValues() = %v, want %v", got, want)
+	}
+
+	reason, _ := description.Field("Reason__c")
+	got, err = description.DependentValues(*reason)
+	if err != nil {
+		t.Fatalf("DependentValues() error = %v", err)
+	}
+	want = map[string][]string{"false": {"Churned"}, "true": {}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DependentValues() = %v, want %v", got, want)
+	}
+
+	region, _ := description.Field("Region__c")
+	_, err = description.DependentValues(*region)
+	if err == nil {
+		t.Error("DependentValues() succeeded for an independent picklist")
+	}
+}
+
+func stringPointer(s string) *string {
+	return &s
+}
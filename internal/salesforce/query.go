@@ -0,0 +1,89 @@
+package salesforce
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// QueryOptions controls how Query runs a SOQL query.
+type QueryOptions struct {
+	// IncludeDeleted also returns deleted and archived records through the
+	// queryAll endpoint.
+	IncludeDeleted bool
+	// MaxRecords stops fetching further batches once this many records were
+	// returned. Zero returns all records.
+	MaxRecords int
+}
+
+// Query - Runs a SOQL query and follows nextRecordsUrl until all records, or
+// MaxRecords of them, were fetched. The result holds the records of all
+// batches, TotalSize is the number of records matching the query.
+func (c *Client) Query(ctx context.Context, soql string, options QueryOptions) (*QueryResult, error) {
+
+	endpoint := "query"
+	if options.IncludeDeleted {
+		endpoint = "queryAll"
+	}
+
+	next := fmt.Sprintf(
+		"/services/data/%s/%s?q=%s",
+		c.ApiVersion,
+		endpoint,
+		url.QueryEscape(soql),
+	)
+
+	result := &QueryResult{}
+	for next != "" {
+		page, err := c.queryPage(ctx, next)
+		if err != nil {
+			return nil, err
+		}
+
+		result.TotalSize = page.TotalSize
+		result.Done = page.Done
+		result.Records = append(result.Records, page.Records...)
+
+		next = ""
+		if !page.Done {
+			next = page.NextRecordsURL
+		}
+
+		if options.MaxRecords > 0 && len(result.Records) >= options.MaxRecords {
+			result.Records = result.Records[:options.MaxRecords]
+			break
+		}
+	}
+
+	return result, nil
+}
+
+// queryPage fetches one batch of query results from path, which is relative
+// to the instance URL like nextRecordsUrl.
+func (c *Client) queryPage(ctx context.Context, path string) (*QueryResult, error) {
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"GET",
+		c.instanceURL()+path,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &QueryResult{}
+	err = json.Unmarshal(body, page)
+	if err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
@@ -0,0 +1,318 @@
+package salesforce
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// GetRecord - Returns the record of sObjectType with id, limited to fields
+// unless they are empty.
+func (c *Client) GetRecord(ctx context.Context, sObjectType, id string, fields []string) (Record, error) {
+	return c.getRecord(ctx, fmt.Sprintf(
+		"%s/services/data/%s/sobjects/%s/%s",
+		c.instanceURL(),
+		c.ApiVersion,
+		sObjectType,
+		url.PathEscape(id),
+	), fields)
+}
+
+// GetRecordByExternalID - Returns the record of sObjectType whose external ID
+// field has value, limited to fields unless they are empty.
+func (c *Client) GetRecordByExternalID(ctx context.Context, sObjectType, field, value string, fields []string) (Record, error) {
+	return c.getRecord(ctx, fmt.Sprintf(
+		"%s/services/data/%s/sobjects/%s/%s/%s",
+		c.instanceURL(),
+		c.ApiVersion,
+		sObjectType,
+		field,
+		url.PathEscape(value),
+	), fields)
+}
+
+func (c *Client) getRecord(ctx context.Context, recordURL string, fields []string) (Record, error) {
+
+	if len(fields) > 0 {
+		recordURL += "?fields=" + url.QueryEscape(strings.Join(fields, ","))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", recordURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	record := Record{}
+	err = json.Unmarshal(body, &record)
+	if err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// ID returns the ID of the record, taken from the URL in its attributes when
+// the Id field was not selected.
+func (r Record) ID() string {
+	var id string
+	if err := json.Unmarshal(r["Id"], &id); err == nil && id != "" {
+		return id
+	}
+
+	var attributes struct {
+		URL string `json:"url"`
+	}
+	if err := json.Unmarshal(r["attributes"], &attributes); err != nil || attributes.URL == "" {
+		return ""
+	}
+
+	return path.Base(attributes.URL)
+}
+
+// SaveResult is the response to creating or upserting a record.
+type SaveResult struct {
+	ID      string        `json:"id"`
+	Success bool          `json:"success"`
+	Created bool          `json:"created"`
+	Errors  []ErrorDetail `json:"errors"`
+}
+
+// CreateRecord - Creates a record of sObjectType with the field values and
+// returns its ID.
+func (c *Client) CreateRecord(ctx context.Context, sObjectType string, values map[string]any) (string, error) {
+
+	payload, err := json.Marshal(values)
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"POST",
+		fmt.Sprintf(
+			"%s/services/data/%s/sobjects/%s",
+			c.instanceURL(),
+			c.ApiVersion,
+			sObjectType,
+		),
+		bytes.NewReader(payload),
+	)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, body, err := c.do(req)
+	if err != nil {
+		return "", err
+	}
+	if res.StatusCode != http.StatusCreated {
+		return "", newAPIError(res.StatusCode, body)
+	}
+
+	result := &SaveResult{}
+	err = json.Unmarshal(body, result)
+	if err != nil {
+		return "", err
+	}
+	if !result.Success {
+		return "", &APIError{StatusCode: res.StatusCode, Errors: result.Errors}
+	}
+
+	return result.ID, nil
+}
+
+// UpsertRecord - Updates the record of sObjectType whose external ID field
+// has value, or creates it if there is none. It returns the ID of the record
+// and whether it was created.
+func (c *Client) UpsertRecord(ctx context.Context, sObjectType, field, value string, values map[string]any) (string, bool, error) {
+
+	payload, err := json.Marshal(values)
+	if err != nil {
+		return "", false, err
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"PATCH",
+		fmt.Sprintf(
+			"%s/services/data/%s/sobjects/%s/%s/%s",
+			c.instanceURL(),
+			c.ApiVersion,
+			sObjectType,
+			field,
+			url.PathEscape(value),
+		),
+		bytes.NewReader(payload),
+	)
+	if err != nil {
+		return "", false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, body, err := c.do(req)
+	if err != nil {
+		return "", false, err
+	}
+
+	switch res.StatusCode {
+	case http.StatusCreated, http.StatusOK:
+		result := &SaveResult{}
+		err = json.Unmarshal(body, result)
+		if err != nil {
+			return "", false, err
+		}
+		if !result.Success {
+			return "", false, &APIError{StatusCode: res.StatusCode, Errors: result.Errors}
+		}
+		return result.ID, res.StatusCode == http.StatusCreated, nil
+	case http.StatusNoContent:
+		// API versions before 46.0 answer updates without a body
+		record, err := c.GetRecordByExternalID(ctx, sObjectType, field, value, []string{"Id"})
+		if err != nil {
+			return "", false, err
+		}
+		return record.ID(), false, nil
+	default:
+		return "", false, newAPIError(res.StatusCode, body)
+	}
+}
+
+// UpdateRecord - Sets the field values of the record of sObjectType with id.
+// A nil value clears the field.
+func (c *Client) UpdateRecord(ctx context.Context, sObjectType, id string, values map[string]any) error {
+
+	payload, err := json.Marshal(values)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"PATCH",
+		fmt.Sprintf(
+			"%s/services/data/%s/sobjects/%s/%s",
+			c.instanceURL(),
+			c.ApiVersion,
+			sObjectType,
+			url.PathEscape(id),
+		),
+		bytes.NewReader(payload),
+	)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	return c.doNoContent(req)
+}
+
+// DeleteRecord - Deletes the record of sObjectType with id.
+func (c *Client) DeleteRecord(ctx context.Context, sObjectType, id string) error {
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"DELETE",
+		fmt.Sprintf(
+			"%s/services/data/%s/sobjects/%s/%s",
+			c.instanceURL(),
+			c.ApiVersion,
+			sObjectType,
+			url.PathEscape(id),
+		),
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+
+	return c.doNoContent(req)
+}
+
+// doNoContent sends req, which succeeds with an empty response.
+func (c *Client) doNoContent(req *http.Request) error {
+	res, body, err := c.do(req)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode != http.StatusNoContent && res.StatusCode != http.StatusOK {
+		return newAPIError(res.StatusCode, body)
+	}
+
+	return nil
+}
+
+// ParseValue converts the string representation of a value of the field to
+// the JSON value the REST API expects. An empty string clears the field.
+func (f *DescriptionField) ParseValue(value string) (any, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	switch f.Type {
+	case "boolean":
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a boolean", value)
+		}
+		return parsed, nil
+	case "int":
+		parsed, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not an integer", value)
+		}
+		return parsed, nil
+	case "double", "currency", "percent":
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return nil, fmt.Errorf("%q is not a number", value)
+		}
+		return json.Number(value), nil
+	case "date":
+		if _, err := time.Parse("2006-01-02", value); err != nil {
+			return nil, fmt.Errorf("%q is not a date such as 2024-01-31", value)
+		}
+	case "datetime":
+		if _, err := time.Parse(time.RFC3339, value); err != nil {
+			return nil, fmt.Errorf("%q is not a date and time such as 2024-01-31T12:00:00Z", value)
+		}
+	}
+
+	return value, nil
+}
+
+// FormatKey formats value, as returned by ParseValue, as the key of an upsert
+// by the field. Numbers are written in decimal notation with the scale of the
+// field, as keys in exponent notation such as 1e+06 do not match records.
+func (f *DescriptionField) FormatKey(value any) (string, error) {
+	switch value := value.(type) {
+	case string:
+		return value, nil
+	case bool:
+		return strconv.FormatBool(value), nil
+	case int64:
+		return strconv.FormatInt(value, 10), nil
+	case json.Number:
+		number, ok := new(big.Rat).SetString(value.String())
+		if !ok {
+			return "", fmt.Errorf("%q is not a number", value)
+		}
+		return number.FloatString(f.Scale), nil
+	case nil:
+		return "", fmt.Errorf("field %s has no value", f.Name)
+	default:
+		return "", fmt.Errorf("unsupported value %v of field %s", value, f.Name)
+	}
+}
@@ -0,0 +1,134 @@
+package salesforce
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestClientUpsertRecord(t *testing.T) {
+	tests := map[string]struct {
+		statusCode  int
+		body        string
+		wantID      string
+		wantCreated bool
+		wantErr     bool
+	}{
+		"created": {
+			statusCode:  http.StatusCreated,
+			body:        `{"id":"a0B000000000001AAA","success":true,"errors":[],"created":true}`,
+			wantID:      "a0B000000000001AAA",
+			wantCreated: true,
+		},
+		"updated": {
+			statusCode: http.StatusOK,
+			body:       `{"id":"a0B000000000002AAA","success":true,"errors":[],"created":false}`,
+			wantID:     "a0B000000000002AAA",
+		},
+		"updated without body": {
+			statusCode: http.StatusNoContent,
+			wantID:     "a0B000000000003AAA",
+		},
+		"failed": {
+			statusCode: http.StatusBadRequest,
+			body:       `[{"errorCode":"INVALID_FIELD_FOR_INSERT_UPDATE","message":"Unable to create/update fields: vub_Region__c."}]`,
+			wantErr:    true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			recordPath := "/services/data/v59.0/sobjects/vub_Setting__c/vub_Key__c/DEFAULT%2F1"
+			client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.EscapedPath() != recordPath {
+					t.Errorf("path = %s, want %s", r.URL.EscapedPath(), recordPath)
+				}
+				w.Header().Set("Content-Type", "application/json")
+
+				// Updates without a response body are followed by a lookup of the ID
+				if r.Method == http.MethodGet {
+					if got := r.URL.Query().Get("fields"); got != "Id" {
+						t.Errorf("fields = %q, want Id", got)
+					}
+					_, _ = w.Write([]byte(`{"attributes":{"type":"vub_Setting__c"},"Id":"a0B000000000003AAA"}`))
+					return
+				}
+
+				if r.Method != http.MethodPatch {
+					t.Errorf("method = %s, want PATCH", r.Method)
+				}
+				var values map[string]any
+				if err := json.NewDecoder(r.Body).Decode(&values); err != nil || !reflect.DeepEqual(values, map[string]any{"Name": "Default"}) {
+					t.Errorf("body = %v (%v), want the values without the external ID", values, err)
+				}
+				w.WriteHeader(test.statusCode)
+				_, _ = w.Write([]byte(test.body))
+			}), &Token{AccessToken: "token"}, nil)
+
+			id, created, err := client.UpsertRecord(context.Background(), "vub_Setting__c", "vub_Key__c", "DEFAULT/1", map[string]any{"Name": "Default"})
+			if (err != nil) != test.wantErr {
+				t.Fatalf("UpsertRecord() error = %v, wantErr %t", err, test.wantErr)
+			}
+			if id != test.wantID || created != test.wantCreated {
+				t.Errorf("UpsertRecord() = %q, %t, want %q, %t", id, created, test.wantID, test.wantCreated)
+			}
+		})
+	}
+}
+
+func TestDescriptionFieldFormatKey(t *testing.T) {
+	tests := map[string]struct {
+		field   DescriptionField
+		value   string
+		want    string
+		wantErr bool
+	}{
+		"text": {
+			field: DescriptionField{Name: "vub_Key__c", Type: "string"},
+			value: "DEFAULT/1",
+			want:  "DEFAULT/1",
+		},
+		"integer": {
+			field: DescriptionField{Name: "vub_Number__c", Type: "int"},
+			value: "1000000",
+			want:  "1000000",
+		},
+		"number in exponent notation": {
+			field: DescriptionField{Name: "vub_Number__c", Type: "double"},
+			value: "1e6",
+			want:  "1000000",
+		},
+		"number with scale": {
+			field: DescriptionField{Name: "vub_Number__c", Type: "double", Scale: 2},
+			value: "12.5",
+			want:  "12.50",
+		},
+		"large number": {
+			field: DescriptionField{Name: "vub_Number__c", Type: "double"},
+			value: "123456789012345678",
+			want:  "123456789012345678",
+		},
+		"empty": {
+			field:   DescriptionField{Name: "vub_Key__c", Type: "string"},
+			wantErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			value, err := test.field.ParseValue(test.value)
+			if err != nil {
+				t.Fatalf("ParseValue() error = %v", err)
+			}
+			got, err := test.field.FormatKey(value)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("FormatKey() error = %v, wantErr %t", err, test.wantErr)
+			}
+			if got != test.want {
+				t.Errorf("FormatKey() = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
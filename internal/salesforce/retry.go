@@ -0,0 +1,142 @@
+package salesforce
+
+import (
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Error codes of transient failures that are retried by default.
+const (
+	ErrorCodeUnableToLockRow   = "UNABLE_TO_LOCK_ROW"
+	ErrorCodeServerUnavailable = "SERVER_UNAVAILABLE"
+)
+
+// RetryPolicy controls how the Client retries requests after transient
+// failures. Idempotent requests are retried after responses with status 429,
+// 502, 503 or 504 and after error responses carrying one of ErrorCodes.
+// Other requests such as POST may already have been applied when the
+// response failed, so they are only retried after status 429 or 503 with one
+// of ErrorCodes, which shows Salesforce rejected them without processing.
+type RetryPolicy struct {
+	MaxRetries int
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+	ErrorCodes []string
+}
+
+// DefaultRetryPolicy returns the policy used unless the provider configures
+// a different one. REQUEST_LIMIT_EXCEEDED is not retried by default, as it
+// usually means the daily API request limit is used up, which no backoff
+// outlasts.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries: 3,
+		MinBackoff: time.Second,
+		MaxBackoff: 30 * time.Second,
+		ErrorCodes: []string{
+			ErrorCodeUnableToLockRow,
+			ErrorCodeServerUnavailable,
+		},
+	}
+}
+
+// shouldRetry reports whether a request with method that got a response
+// with statusCode and body is worth sending again.
+func (p RetryPolicy) shouldRetry(method string, statusCode int, body []byte) bool {
+	if !isIdempotent(method) {
+		switch statusCode {
+		case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+			return p.hasErrorCode(statusCode, body)
+		default:
+			return false
+		}
+	}
+
+	switch statusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	if statusCode < http.StatusBadRequest {
+		return false
+	}
+
+	return p.hasErrorCode(statusCode, body)
+}
+
+// hasErrorCode reports whether the REST error or SOAP fault in body carries
+// one of ErrorCodes.
+func (p RetryPolicy) hasErrorCode(statusCode int, body []byte) bool {
+	if fault := parseSOAPFault(statusCode, body); fault != nil {
+		for _, code := range p.ErrorCodes {
+			if fault.Code == code {
+				return true
+			}
+		}
+		return false
+	}
+
+	return newAPIError(statusCode, body).HasErrorCode(p.ErrorCodes...)
+}
+
+// backoff returns how long to wait before retry number attempt, counted from
+// zero. A Retry-After header of the response takes precedence over the
+// exponential backoff, but both are capped at MaxBackoff.
+func (p RetryPolicy) backoff(attempt int, header http.Header) time.Duration {
+	if wait, ok := retryAfter(header); ok {
+		return p.capBackoff(wait)
+	}
+
+	wait := p.MinBackoff << attempt
+	// Fall back to the maximum when the shift overflows, a MinBackoff of zero
+	// still retries without waiting.
+	if p.MinBackoff > 0 && wait < p.MinBackoff {
+		wait = p.MaxBackoff
+	}
+	// Add up to 50% jitter so concurrent requests do not retry in lockstep.
+	if wait > 0 {
+		wait += time.Duration(rand.Int63n(int64(wait)/2 + 1))
+	}
+
+	return p.capBackoff(wait)
+}
+
+func (p RetryPolicy) capBackoff(wait time.Duration) time.Duration {
+	if p.MaxBackoff > 0 && wait > p.MaxBackoff {
+		return p.MaxBackoff
+	}
+
+	return wait
+}
+
+// retryAfter parses a Retry-After header given in seconds or as HTTP date.
+func retryAfter(header http.Header) (time.Duration, bool) {
+	value := header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+
+	return 0, false
+}
+
+// isIdempotent reports whether a request with method can be sent again after
+// a network error without the risk of applying it twice.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
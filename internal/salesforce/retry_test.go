@@ -0,0 +1,272 @@
+package salesforce
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := RetryPolicy{MinBackoff: time.Second, MaxBackoff: 10 * time.Second}
+
+	tests := map[string]struct {
+		attempt int
+		header  http.Header
+		min     time.Duration
+		max     time.Duration
+	}{
+		"first retry": {
+			attempt: 0,
+			min:     time.Second,
+			max:     1500 * time.Millisecond,
+		},
+		"doubled": {
+			attempt: 2,
+			min:     4 * time.Second,
+			max:     6 * time.Second,
+		},
+		"capped": {
+			attempt: 5,
+			min:     10 * time.Second,
+			max:     10 * time.Second,
+		},
+		"overflow": {
+			attempt: 80,
+			min:     10 * time.Second,
+			max:     10 * time.Second,
+		},
+		"retry after": {
+			attempt: 0,
+			header:  http.Header{"Retry-After": []string{"3"}},
+			min:     3 * time.Second,
+			max:     3 * time.Second,
+		},
+		"retry after capped": {
+			attempt: 0,
+			header:  http.Header{"Retry-After": []string{"120"}},
+			min:     10 * time.Second,
+			max:     10 * time.Second,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			for i := 0; i < 20; i++ {
+				got := policy.backoff(test.attempt, test.header)
+				if got < test.min || got > test.max {
+					t.Fatalf("backoff() = %s, want between %s and %s", got, test.min, test.max)
+				}
+			}
+		})
+	}
+}
+
+func TestRetryPolicyBackoffWithoutMinimum(t *testing.T) {
+	policy := RetryPolicy{MinBackoff: 0, MaxBackoff: 30 * time.Second}
+
+	for _, attempt := range []int{0, 1, 5, 80} {
+		if got := policy.backoff(attempt, nil); got != 0 {
+			t.Errorf("backoff(%d) = %s, want no wait", attempt, got)
+		}
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	tests := map[string]struct {
+		value  string
+		want   time.Duration
+		wantOK bool
+	}{
+		"missing": {},
+		"seconds": {
+			value:  "5",
+			want:   5 * time.Second,
+			wantOK: true,
+		},
+		"negative": {
+			value: "-5",
+		},
+		"past date": {
+			value:  "Wed, 21 Oct 2015 07:28:00 GMT",
+			want:   0,
+			wantOK: true,
+		},
+		"invalid": {
+			value: "soon",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			header := http.Header{}
+			if test.value != "" {
+				header.Set("Retry-After", test.value)
+			}
+			got, ok := retryAfter(header)
+			if got != test.want || ok != test.wantOK {
+				t.Errorf("retryAfter() = %s, %t, want %s, %t", got, ok, test.want, test.wantOK)
+			}
+		})
+	}
+
+	t.Run("future date", func(t *testing.T) {
+		header := http.Header{"Retry-After": []string{time.Now().Add(time.Minute).UTC().Format(http.TimeFormat)}}
+		got, ok := retryAfter(header)
+		if !ok || got <= 50*time.Second || got > time.Minute {
+			t.Errorf("retryAfter() = %s, %t, want about a minute", got, ok)
+		}
+	})
+}
+
+func TestRetryPolicyShouldRetry(t *testing.T) {
+	policy := DefaultRetryPolicy()
+	serverUnavailable := `[{"message":"Server unavailable","errorCode":"SERVER_UNAVAILABLE"}]`
+	lockedRow := `[{"message":"unable to obtain exclusive access to this record","errorCode":"UNABLE_TO_LOCK_ROW"}]`
+	limitExceeded := `[{"message":"TotalRequests Limit exceeded.","errorCode":"REQUEST_LIMIT_EXCEEDED"}]`
+	lockedRowFault := `<?xml version="1.0" encoding="UTF-8"?><soapenv:Envelope xmlns:soapenv="http://schemas.xmlsoap.org/soap/envelope/"><soapenv:Body><soapenv:Fault><faultcode>sf:UNABLE_TO_LOCK_ROW</faultcode><faultstring>UNABLE_TO_LOCK_ROW: locked</faultstring></soapenv:Fault></soapenv:Body></soapenv:Envelope>`
+
+	tests := map[string]struct {
+		method     string
+		statusCode int
+		body       string
+		want       bool
+	}{
+		"get success": {
+			method:     http.MethodGet,
+			statusCode: http.StatusOK,
+		},
+		"get bad gateway": {
+			method:     http.MethodGet,
+			statusCode: http.StatusBadGateway,
+			want:       true,
+		},
+		"get too many requests": {
+			method:     http.MethodGet,
+			statusCode: http.StatusTooManyRequests,
+			want:       true,
+		},
+		"delete locked row": {
+			method:     http.MethodDelete,
+			statusCode: http.StatusBadRequest,
+			body:       lockedRow,
+			want:       true,
+		},
+		"get limit exceeded": {
+			method:     http.MethodGet,
+			statusCode: http.StatusForbidden,
+			body:       limitExceeded,
+		},
+		"get soap fault": {
+			method:     http.MethodGet,
+			statusCode: http.StatusInternalServerError,
+			body:       lockedRowFault,
+			want:       true,
+		},
+		"get bad request": {
+			method:     http.MethodGet,
+			statusCode: http.StatusBadRequest,
+			body:       `[{"message":"malformed","errorCode":"MALFORMED_QUERY"}]`,
+		},
+		"post bad gateway": {
+			method:     http.MethodPost,
+			statusCode: http.StatusBadGateway,
+		},
+		"post gateway timeout": {
+			method:     http.MethodPost,
+			statusCode: http.StatusGatewayTimeout,
+		},
+		"post unavailable without code": {
+			method:     http.MethodPost,
+			statusCode: http.StatusServiceUnavailable,
+			body:       "<html>Service Unavailable</html>",
+		},
+		"post unavailable rejected": {
+			method:     http.MethodPost,
+			statusCode: http.StatusServiceUnavailable,
+			body:       serverUnavailable,
+			want:       true,
+		},
+		"post locked row": {
+			method:     http.MethodPost,
+			statusCode: http.StatusBadRequest,
+			body:       lockedRow,
+		},
+		"patch too many requests rejected": {
+			method:     http.MethodPatch,
+			statusCode: http.StatusTooManyRequests,
+			body:       serverUnavailable,
+			want:       true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := policy.shouldRetry(test.method, test.statusCode, []byte(test.body))
+			if got != test.want {
+				t.Errorf("shouldRetry() = %t, want %t", got, test.want)
+			}
+		})
+	}
+}
+
+func TestClientRetries(t *testing.T) {
+	tests := map[string]struct {
+		method       string
+		statusCode   int
+		body         string
+		wantRequests int32
+		wantStatus   int
+	}{
+		"get recovers": {
+			method:       http.MethodGet,
+			statusCode:   http.StatusServiceUnavailable,
+			wantRequests: 2,
+			wantStatus:   http.StatusOK,
+		},
+		"post not retried": {
+			method:       http.MethodPost,
+			statusCode:   http.StatusBadGateway,
+			wantRequests: 1,
+			wantStatus:   http.StatusBadGateway,
+		},
+		"post rejected": {
+			method:       http.MethodPost,
+			statusCode:   http.StatusServiceUnavailable,
+			body:         `[{"message":"Server unavailable","errorCode":"SERVER_UNAVAILABLE"}]`,
+			wantRequests: 2,
+			wantStatus:   http.StatusOK,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var requests atomic.Int32
+			client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if requests.Add(1) == 1 {
+					w.WriteHeader(test.statusCode)
+					_, _ = w.Write([]byte(test.body))
+					return
+				}
+				_, _ = w.Write([]byte(`{}`))
+			}), nil, nil)
+
+			req, err := http.NewRequestWithContext(context.Background(), test.method, client.HostURL+"/services/data/v59.0/sobjects/Account", strings.NewReader(`{}`))
+			if err != nil {
+				t.Fatal(err)
+			}
+			res, _, err := client.do(req)
+			if err != nil {
+				t.Fatalf("do() error = %v", err)
+			}
+			if res.StatusCode != test.wantStatus {
+				t.Errorf("status = %d, want %d", res.StatusCode, test.wantStatus)
+			}
+			if got := requests.Load(); got != test.wantRequests {
+				t.Errorf("sent %d requests, want %d", got, test.wantRequests)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package salesforce
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Actions of an APIUsageLimit.
+const (
+	APIUsageActionStop     = "stop"
+	APIUsageActionThrottle = "throttle"
+)
+
+// APIUsage is the daily API request usage of the org as reported by the
+// Sforce-Limit-Info header.
+type APIUsage struct {
+	Used  int
+	Limit int
+}
+
+// Percent returns the share of the daily limit that is used up.
+func (u APIUsage) Percent() float64 {
+	if u.Limit <= 0 {
+		return 0
+	}
+
+	return float64(u.Used) * 100 / float64(u.Limit)
+}
+
+// APIUsageLimit keeps a Terraform run from using up the daily API requests
+// an org shares with other integrations. Once usage passes Threshold percent,
+// requests either fail or are delayed by Delay, depending on Action. A zero
+// Threshold disables the limit.
+type APIUsageLimit struct {
+	Threshold float64
+	Action    string
+	Delay     time.Duration
+}
+
+// exceeded reports whether usage passed the threshold of the limit.
+func (l APIUsageLimit) exceeded(usage APIUsage) bool {
+	return l.Threshold > 0 && usage.Limit > 0 && usage.Percent() >= l.Threshold
+}
+
+// APIUsageLimitError is returned instead of sending a request once the
+// APIUsageLimit with action stop is exceeded.
+type APIUsageLimitError struct {
+	Usage     APIUsage
+	Threshold float64
+}
+
+func (e *APIUsageLimitError) Error() string {
+	return fmt.Sprintf("API usage of %d/%d requests (%.1f%%) exceeds the configured threshold of %.1f%%",
+		e.Usage.Used, e.Usage.Limit, e.Usage.Percent(), e.Threshold)
+}
+
+// parseLimitInfo reads the api-usage entry of a Sforce-Limit-Info header,
+// e.g. "api-usage=25/15000; per-app-api-usage=17/250(appName=sample)".
+func parseLimitInfo(header http.Header) (APIUsage, bool) {
+	for _, entry := range strings.Split(header.Get("Sforce-Limit-Info"), ";") {
+		name, value, found := strings.Cut(strings.TrimSpace(entry), "=")
+		if !found || name != "api-usage" {
+			continue
+		}
+		used, limit, found := strings.Cut(value, "/")
+		if !found {
+			return APIUsage{}, false
+		}
+		usedCount, err := strconv.Atoi(used)
+		if err != nil {
+			return APIUsage{}, false
+		}
+		limitCount, err := strconv.Atoi(limit)
+		if err != nil {
+			return APIUsage{}, false
+		}
+		return APIUsage{Used: usedCount, Limit: limitCount}, true
+	}
+
+	return APIUsage{}, false
+}
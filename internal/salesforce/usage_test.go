@@ -0,0 +1,118 @@
+package salesforce
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestParseLimitInfo(t *testing.T) {
+	tests := map[string]struct {
+		value  string
+		want   APIUsage
+		wantOK bool
+	}{
+		"missing": {},
+		"api usage": {
+			value:  "api-usage=25/15000",
+			want:   APIUsage{Used: 25, Limit: 15000},
+			wantOK: true,
+		},
+		"with app usage": {
+			value:  "per-app-api-usage=17/250(appName=sample); api-usage=25/15000",
+			want:   APIUsage{Used: 25, Limit: 15000},
+			wantOK: true,
+		},
+		"only app usage": {
+			value: "per-app-api-usage=17/250(appName=sample)",
+		},
+		"no limit": {
+			value: "api-usage=25",
+		},
+		"not a number": {
+			value: "api-usage=many/15000",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			header := http.Header{}
+			if test.value != "" {
+				header.Set("Sforce-Limit-Info", test.value)
+			}
+			got, ok := parseLimitInfo(header)
+			if got != test.want || ok != test.wantOK {
+				t.Errorf("parseLimitInfo() = %+v, %t, want %+v, %t", got, ok, test.want, test.wantOK)
+			}
+		})
+	}
+}
+
+func TestAPIUsageLimitExceeded(t *testing.T) {
+	usage := APIUsage{Used: 12000, Limit: 15000}
+
+	tests := map[string]struct {
+		limit APIUsageLimit
+		usage APIUsage
+		want  bool
+	}{
+		"disabled": {
+			usage: usage,
+		},
+		"below": {
+			limit: APIUsageLimit{Threshold: 90},
+			usage: usage,
+		},
+		"reached": {
+			limit: APIUsageLimit{Threshold: 80},
+			usage: usage,
+			want:  true,
+		},
+		"unknown usage": {
+			limit: APIUsageLimit{Threshold: 80},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := test.limit.exceeded(test.usage); got != test.want {
+				t.Errorf("exceeded() = %t, want %t", got, test.want)
+			}
+		})
+	}
+}
+
+func TestClientStopsAtAPIUsageLimit(t *testing.T) {
+	var requests int
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Sforce-Limit-Info", "api-usage=14000/15000")
+		_, _ = w.Write([]byte(`{}`))
+	}), nil, nil)
+	client.UsageLimit = APIUsageLimit{Threshold: 90, Action: APIUsageActionStop}
+
+	for i := 0; i < 2; i++ {
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, client.HostURL+"/services/data/v59.0/limits", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = client.doRequest(req)
+		if i == 0 && err != nil {
+			t.Fatalf("first request error = %v", err)
+		}
+		if i == 1 {
+			var limitErr *APIUsageLimitError
+			if !errors.As(err, &limitErr) {
+				t.Fatalf("second request error = %v, want APIUsageLimitError", err)
+			}
+		}
+	}
+
+	if got := client.APIUsage(); got != (APIUsage{Used: 14000, Limit: 15000}) {
+		t.Errorf("APIUsage() = %+v", got)
+	}
+	if requests != 1 {
+		t.Errorf("sent %d requests, want 1", requests)
+	}
+}